@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aWarmWalrus/nyt-games/internal/boardimage"
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// exportImage renders the puzzle (with chains' chords overlaid) to path,
+// choosing SVG or PNG by its extension.
+func exportImage(path string, p letterboxed.Puzzle, chains [][]string) error {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".svg":
+		return os.WriteFile(path, []byte(boardimage.RenderSVG(p, chains, 400)), 0o644)
+	case ".png":
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("export image: %w", err)
+		}
+		defer f.Close()
+		return png.Encode(f, boardimage.RenderPNG(p, chains, 400))
+	default:
+		return fmt.Errorf("export image: unsupported extension %q (use .svg or .png)", ext)
+	}
+}
+
+// renderBox draws the puzzle's four sides as an ASCII box, with sides[0]
+// across the top, sides[1] down the right, sides[2] across the bottom, and
+// sides[3] down the left. Letters already in visited are upper-cased so
+// the path traced so far stands out against the rest of the box.
+func renderBox(p letterboxed.Puzzle, visited map[byte]bool) string {
+	letter := func(b byte) string {
+		if visited[b] {
+			return strings.ToUpper(string(b))
+		}
+		return string(b)
+	}
+	top, right, bottom, left := p.Sides[0], p.Sides[1], p.Sides[2], p.Sides[3]
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "   %s %s %s\n", letter(top[0]), letter(top[1]), letter(top[2]))
+	fmt.Fprintf(&b, " %s       %s\n", letter(left[0]), letter(right[0]))
+	fmt.Fprintf(&b, " %s       %s\n", letter(left[1]), letter(right[1]))
+	fmt.Fprintf(&b, " %s       %s\n", letter(left[2]), letter(right[2]))
+	fmt.Fprintf(&b, "   %s %s %s\n", letter(bottom[0]), letter(bottom[1]), letter(bottom[2]))
+	return b.String()
+}
+
+// animateSolution prints one frame of the box diagram per letter of chain,
+// each frame highlighting every letter used so far and the path traced
+// through them, pausing frameDelay between frames so the solve can be
+// watched unfold instead of just read as text. A frameDelay of 0 prints
+// every frame immediately, which is useful for tests.
+func animateSolution(out io.Writer, p letterboxed.Puzzle, chain []string, frameDelay time.Duration) {
+	visited := make(map[byte]bool)
+	var path []string
+	for _, word := range chain {
+		for i := 0; i < len(word); i++ {
+			visited[word[i]] = true
+			path = append(path, string(word[i]))
+			fmt.Fprint(out, renderBox(p, visited))
+			fmt.Fprintln(out, strings.Join(path, " -> "))
+			fmt.Fprintln(out)
+			if frameDelay > 0 {
+				time.Sleep(frameDelay)
+			}
+		}
+	}
+}
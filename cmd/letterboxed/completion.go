@@ -0,0 +1,28 @@
+package main
+
+import "fmt"
+
+// bashCompletion is a static completion script listing the CLI's flags.
+// It's hand-written rather than derived from the flag.FlagSet, since the
+// flag set doesn't carry a stable iteration order and the list changes
+// rarely.
+const bashCompletion = `_letterboxed() {
+    local cur prev opts
+    COMPREPLY=()
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    opts="--sides --count-solutions --archive --concurrency --max-results --daemon --sqlite-dict --rule-script --daily --no-hints --completion --puzzle-url --puzzle-cache --batch --report --webhook --exclude-forms --first-starts-with --avoid-suffix --must-use --tutorial --screen-reader --large-print --lang --replay --replay-real-time --notify"
+    COMPREPLY=( $(compgen -W "${opts}" -- "${cur}") )
+    return 0
+}
+complete -F _letterboxed letterboxed
+`
+
+func printCompletion(shell string) error {
+	switch shell {
+	case "bash":
+		fmt.Print(bashCompletion)
+		return nil
+	default:
+		return fmt.Errorf("letterboxed: unsupported shell %q (supported: bash)", shell)
+	}
+}
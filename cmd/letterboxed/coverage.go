@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// coverageBar renders count out of total as a row of block characters
+// barWidth wide, with a half-block for a fractional final segment.
+func coverageBar(count, total, barWidth int) string {
+	if total <= 0 {
+		return strings.Repeat(" ", barWidth)
+	}
+	filled := float64(count) / float64(total) * float64(barWidth)
+	full := int(filled)
+
+	var b strings.Builder
+	b.WriteString(strings.Repeat("█", full))
+	if filled-float64(full) >= 0.5 {
+		b.WriteString("▌")
+		full++
+	}
+	b.WriteString(strings.Repeat(" ", barWidth-full))
+	return b.String()
+}
+
+// printChainWithCoverage prints chain the usual way, then a per-word
+// coverage bar and the chain's cumulative coverage after each word, so
+// it's obvious at a glance how the solve covers the box.
+func printChainWithCoverage(out io.Writer, p letterboxed.Puzzle, chain []string) {
+	fmt.Fprintln(out, strings.Join(chain, " -> "))
+	for _, step := range letterboxed.ChainCoverage(p, chain) {
+		fmt.Fprintf(out, "  %-10s %s %2d/12  (cumulative %2d/12)\n",
+			strings.ToUpper(step.Word), coverageBar(step.OwnLetters, 12, 10), step.OwnLetters, step.Cumulative)
+	}
+}
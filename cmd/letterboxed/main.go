@@ -0,0 +1,600 @@
+// Command letterboxed is a small CLI for exploring and solving NYT Letter
+// Boxed puzzles against a local dictionary.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aWarmWalrus/nyt-games/internal/clipboard"
+	"github.com/aWarmWalrus/nyt-games/internal/daemon"
+	"github.com/aWarmWalrus/nyt-games/internal/definition"
+	"github.com/aWarmWalrus/nyt-games/internal/display"
+	"github.com/aWarmWalrus/nyt-games/internal/events"
+	"github.com/aWarmWalrus/nyt-games/internal/i18n"
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+	"github.com/aWarmWalrus/nyt-games/internal/notify"
+	"github.com/aWarmWalrus/nyt-games/internal/recording"
+	"github.com/aWarmWalrus/nyt-games/internal/review"
+	"github.com/aWarmWalrus/nyt-games/internal/scripting"
+)
+
+var definitions = definition.NewClient()
+
+func main() {
+	sides := flag.String("sides", "", "comma-separated puzzle sides, e.g. abc,def,ghi,jkl")
+	puzzleURL := flag.String("puzzle-url", "", "fetch the puzzle from a URL serving {\"sides\": [...]} JSON, instead of --sides")
+	puzzleCache := flag.String("puzzle-cache", "", "cache directory for --puzzle-url fetches (empty disables caching)")
+	countSolutions := flag.Bool("count-solutions", false, "count all solutions before starting the REPL, instead of counting in the background")
+	archive := flag.String("archive", "", "path to a file of one puzzle per line (sides comma-separated); solves them all and exits instead of starting the REPL")
+	batch := flag.String("batch", "", "path to a JSON file of puzzles ([{\"sides\": [...]}, ...]); solves them all and streams NDJSON results to stdout instead of starting the REPL")
+	concurrency := flag.Int("concurrency", 4, "number of puzzles to solve in parallel with --archive or --batch")
+	report := flag.String("report", "", "with --archive, print a csv or markdown difficulty/pangram report instead of one line per puzzle")
+	maxResults := flag.Int("max-results", 0, "cap the number of solutions kept in memory at once (0 = unbounded)")
+	daemonSocket := flag.String("daemon", "", "run as a daemon serving solves over this Unix socket path, with a warm dictionary, instead of exiting after one puzzle")
+	webhookURL := flag.String("webhook", "", "with --daemon, POST a summary of each new daily puzzle to this URL at local midnight")
+	sqliteDict := flag.String("sqlite-dict", "", "load the dictionary from a SQLite database (a 'words' table with a 'word' column) instead of the embedded word list")
+	excludeForms := flag.String("exclude-forms", "", "comma-separated word shapes to filter from the dictionary: plural, ing, british")
+	ruleScript := flag.String("rule-script", "", "path to a Starlark script defining an allowed(chain, word) strategy rule applied during solve")
+	firstStartsWith := flag.String("first-starts-with", "", "only find solutions whose first word starts with this letter")
+	avoidSuffix := flag.String("avoid-suffix", "", "reject any word ending in this suffix (e.g. 's' to avoid plurals)")
+	mustUseWord := flag.String("must-use", "", "only keep solutions that use this exact word somewhere in the chain")
+	daily := flag.Bool("daily", false, "generate today's puzzle-of-the-day instead of using --sides")
+	noHints := flag.Bool("no-hints", false, "disable the hint, nudge, reveal, and browse commands, for players who don't trust themselves not to peek")
+	completion := flag.String("completion", "", "print a shell completion script for the given shell (bash) and exit")
+	tutorial := flag.Bool("tutorial", false, "walk through an interactive tutorial on a practice puzzle instead of --sides")
+	screenReader := flag.Bool("screen-reader", false, "use plain, one-item-per-line output instead of column layouts, for screen readers")
+	largePrint := flag.Bool("large-print", false, "spell out words in spaced capital letters, one per line")
+	lang := flag.String("lang", "en", "UI language for status messages (en, es)")
+	replay := flag.String("replay", "", "play back a session recorded with the REPL's 'record' command instead of starting an interactive REPL")
+	replayRealTime := flag.Bool("replay-real-time", false, "pace --replay using the original recorded timestamps instead of playing back as fast as possible")
+	notifyOnFinish := flag.Bool("notify", false, "send a desktop notification (or ring the terminal bell) when a background solve or batch run finishes")
+	flag.Parse()
+
+	if *replay != "" {
+		if err := recording.Replay(*replay, os.Stdout, *replayRealTime); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *completion != "" {
+		if err := printCompletion(*completion); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		return
+	}
+
+	if *tutorial {
+		runTutorial(bufio.NewScanner(os.Stdin))
+		return
+	}
+
+	if *archive != "" {
+		runArchive(*archive, *concurrency, *sqliteDict, *report, *notifyOnFinish)
+		return
+	}
+
+	if *batch != "" {
+		runBatch(*batch, *concurrency, *sqliteDict, *notifyOnFinish)
+		return
+	}
+
+	if *daemonSocket != "" {
+		dict := loadDictionary(*sqliteDict)
+		if *webhookURL != "" {
+			go daemon.RunDailyScheduler(dict, *webhookURL, nil)
+		}
+		if err := daemon.Serve(*daemonSocket, dict); err != nil {
+			fmt.Fprintf(os.Stderr, "letterboxed: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *sides == "" && !*daily && *puzzleURL == "" {
+		fmt.Fprintln(os.Stderr, "usage: letterboxed --sides=abc,def,ghi,jkl (or --daily, or --puzzle-url)")
+		os.Exit(2)
+	}
+
+	dict := loadDictionary(*sqliteDict)
+	if *excludeForms != "" {
+		features, err := parseWordFeatures(*excludeForms)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		dict = letterboxed.FilterByFeatures(dict, features...)
+	}
+
+	var p letterboxed.Puzzle
+	switch {
+	case *daily:
+		generated, err := letterboxed.GenerateDaily(dict, time.Now())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "letterboxed: generate daily puzzle: %v\n", err)
+			os.Exit(1)
+		}
+		p = generated
+	case *puzzleURL != "":
+		fetched, err := letterboxed.FetchPuzzleFromURL(*puzzleURL, *puzzleCache)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		p = fetched
+	default:
+		parsedSides, err := letterboxed.ParseSides(*sides)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		p = letterboxed.NewPuzzle(parsedSides)
+	}
+
+	if *screenReader {
+		fmt.Printf("puzzle sides: side 1 %s, side 2 %s, side 3 %s, side 4 %s (dictionary: %d words)\n",
+			p.Sides[0], p.Sides[1], p.Sides[2], p.Sides[3], dict.Len())
+	} else {
+		fmt.Printf("%s (dictionary: %d words)\n", display.PadRight(p.String(), 20), dict.Len())
+	}
+
+	if err := letterboxed.CheckSolvable(dict, p); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *countSolutions {
+		n := letterboxed.NumberOfSolutions(dict, p, 3)
+		fmt.Printf("%d solutions within 3 words\n", n)
+	} else {
+		// Counting every solution can take noticeably longer than a human
+		// wants to wait at startup, so do it off to the side and report
+		// whenever it finishes instead of blocking the prompt on it.
+		go func() {
+			n := letterboxed.NumberOfSolutions(dict, p, 3)
+			fmt.Printf("\n(%d solutions within 3 words found)\n> ", n)
+			if *notifyOnFinish {
+				notify.Notify(os.Stdout, "letterboxed", fmt.Sprintf("%d solutions found for %s", n, p))
+			}
+		}()
+	}
+
+	var rules []letterboxed.Rule
+	if *ruleScript != "" {
+		rule, err := scripting.ScriptRule(*ruleScript)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		rules = append(rules, rule)
+	}
+	if *firstStartsWith != "" {
+		rules = append(rules, letterboxed.FirstWordStartsWith(strings.ToLower(*firstStartsWith)[0]))
+	}
+	if *avoidSuffix != "" {
+		rules = append(rules, letterboxed.AvoidSuffix(strings.ToLower(*avoidSuffix)))
+	}
+
+	repl(os.Stdin, os.Stdout, dict, p, *maxResults, *noHints, rules, strings.ToLower(*mustUseWord), *screenReader, *largePrint, i18n.Parse(*lang))
+}
+
+// loadDictionary loads the embedded word list, or a SQLite database at
+// sqlitePath if one was given.
+func loadDictionary(sqlitePath string) *letterboxed.Dictionary {
+	if sqlitePath == "" {
+		return letterboxed.DefaultDictionary()
+	}
+	dict, err := letterboxed.NewDictionaryFromSQLite(sqlitePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "letterboxed: %v\n", err)
+		os.Exit(1)
+	}
+	return dict
+}
+
+// parseWordFeatures maps the comma-separated names accepted by
+// --exclude-forms to their letterboxed.WordFeature values.
+func parseWordFeatures(names string) ([]letterboxed.WordFeature, error) {
+	var features []letterboxed.WordFeature
+	for _, name := range strings.Split(names, ",") {
+		switch strings.TrimSpace(name) {
+		case "plural":
+			features = append(features, letterboxed.FeaturePlural)
+		case "ing":
+			features = append(features, letterboxed.FeatureIngForm)
+		case "british":
+			features = append(features, letterboxed.FeatureBritishSpelling)
+		default:
+			return nil, fmt.Errorf("letterboxed: unknown --exclude-forms entry %q (want plural, ing, or british)", name)
+		}
+	}
+	return features, nil
+}
+
+// runArchive solves every puzzle listed in path, one per line as
+// comma-separated sides, and prints each one's solution count. If
+// reportFormat is "csv" or "markdown", it prints a difficulty/pangram
+// report over the whole archive instead.
+func runArchive(path string, concurrency int, sqlitePath, reportFormat string, notifyOnFinish bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "letterboxed: %v\n", err)
+		os.Exit(1)
+	}
+
+	var puzzles []letterboxed.Puzzle
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		parsedSides, err := letterboxed.ParseSides(line)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "letterboxed: skipping %q: %v\n", line, err)
+			continue
+		}
+		puzzles = append(puzzles, letterboxed.NewPuzzle(parsedSides))
+	}
+
+	dict := loadDictionary(sqlitePath)
+	results := letterboxed.BatchSolve(dict, puzzles, 3, concurrency)
+
+	switch reportFormat {
+	case "csv":
+		fmt.Print(letterboxed.BuildArchiveReport(dict, results).CSV())
+	case "markdown":
+		fmt.Print(letterboxed.BuildArchiveReport(dict, results).Markdown())
+	case "":
+		for _, result := range results {
+			fmt.Printf("%s: %d solutions\n", result.Puzzle, len(result.Solutions))
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "letterboxed: unknown --report format %q (want csv or markdown)\n", reportFormat)
+		os.Exit(2)
+	}
+
+	if notifyOnFinish {
+		notify.Notify(os.Stdout, "letterboxed", fmt.Sprintf("archive analysis of %d puzzles finished", len(puzzles)))
+	}
+}
+
+// runBatch solves every puzzle described in the JSON file at path and
+// writes each result to stdout as a line of NDJSON as soon as it's solved,
+// for piping into downstream archive-wide analysis tools.
+func runBatch(path string, concurrency int, sqlitePath string, notifyOnFinish bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "letterboxed: %v\n", err)
+		os.Exit(1)
+	}
+
+	puzzles, err := letterboxed.ParseBatchSpec(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "letterboxed: %v\n", err)
+		os.Exit(1)
+	}
+
+	dict := loadDictionary(sqlitePath)
+	enc := json.NewEncoder(os.Stdout)
+	letterboxed.BatchSolveStream(dict, puzzles, 3, concurrency, func(result letterboxed.BatchResult) {
+		_ = enc.Encode(result)
+	})
+
+	if notifyOnFinish {
+		notify.Notify(os.Stdout, "letterboxed", fmt.Sprintf("batch solve of %d puzzles finished", len(puzzles)))
+	}
+}
+
+// browseSolutions pages through solve results one at a time: 'n' for the
+// next solution, 'q' to return to the main prompt. Solutions are fetched
+// lazily from SolveSeq, so browsing the first few never waits for an
+// exhaustive search to finish.
+func browseSolutions(scanner *bufio.Scanner, out io.Writer, dict *letterboxed.Dictionary, p letterboxed.Puzzle) {
+	next, stop := iter.Pull(letterboxed.SolveSeq(dict, p, 3))
+	defer stop()
+
+	fmt.Fprintln(out, "browsing solutions: 'n' for next, 'q' to stop")
+	i := 0
+	for {
+		chain, ok := next()
+		if !ok {
+			fmt.Fprintln(out, "(no more solutions)")
+			return
+		}
+		i++
+		fmt.Fprintf(out, "[%d] ", i)
+		printChainWithCoverage(out, p, chain)
+
+		fmt.Fprint(out, "n/q> ")
+		if !scanner.Scan() {
+			return
+		}
+		if strings.TrimSpace(scanner.Text()) == "q" {
+			return
+		}
+	}
+}
+
+func repl(in io.Reader, out io.Writer, dict *letterboxed.Dictionary, p letterboxed.Puzzle, maxResults int, noHints bool, rules []letterboxed.Rule, mustUseWord string, screenReader, largePrint bool, lang i18n.Lang) {
+	var session review.Session
+	bus := events.NewBus()
+	bus.Subscribe(events.WordGuessed, func(e events.Event) {
+		session.Record(e.Data.(string))
+	})
+
+	var rec *recording.Recorder
+	var recordPath string
+	var lastResult string
+
+	scanner := bufio.NewScanner(in)
+	fmt.Fprintln(out, "enter a word to check it, 'solve' to search for solutions,")
+	fmt.Fprintln(out, "'add <word>' / 'remove <word>' to edit the dictionary, 'words' to list all")
+	fmt.Fprintln(out, "playable words, 'rules' to see the full game rules, 'record <file>' to log")
+	fmt.Fprintln(out, "the session for later replay, or 'quit' to exit")
+	for {
+		fmt.Fprint(out, "> ")
+		if !scanner.Scan() {
+			return
+		}
+		line := strings.TrimSpace(scanner.Text())
+		if rec != nil {
+			rec.BeginCommand(line)
+		}
+		switch line {
+		case "":
+			continue
+		case "quit", "exit":
+			if rec != nil {
+				if err := rec.Save(recordPath); err != nil {
+					fmt.Fprintln(out, err)
+				}
+			}
+			return
+		case "solve":
+			var results []string
+			record := func(chain []string) {
+				if mustUseWord != "" && len(letterboxed.FilterContainingWord([][]string{chain}, mustUseWord)) == 0 {
+					return
+				}
+				printChainWithCoverage(out, p, chain)
+				results = append(results, strings.Join(chain, " -> "))
+			}
+			switch {
+			case len(rules) > 0:
+				for _, chain := range letterboxed.SolveWithRules(dict, p, 3, rules) {
+					record(chain)
+				}
+			case maxResults > 0:
+				for _, chain := range letterboxed.SolveBounded(dict, p, 3, maxResults) {
+					record(chain)
+				}
+			default:
+				for chain := range letterboxed.SolveSeq(dict, p, 3) {
+					record(chain)
+				}
+			}
+			lastResult = strings.Join(results, "\n")
+		case "copy":
+			if lastResult == "" {
+				fmt.Fprintln(out, "nothing to copy yet; run 'solve' first")
+				continue
+			}
+			if err := clipboard.Copy(lastResult); err != nil {
+				fmt.Fprintln(out, err)
+			} else {
+				fmt.Fprintln(out, "copied last solve result to the clipboard")
+			}
+		case "browse":
+			if noHints {
+				fmt.Fprintln(out, i18n.T(lang, "hints_disabled"))
+				continue
+			}
+			browseSolutions(scanner, out, dict, p)
+		case "words":
+			words := letterboxed.AllValidWords(dict, p)
+			labeled := make([]string, len(words))
+			for i, w := range words {
+				if letterboxed.IsObscure(w) {
+					labeled[i] = w + "*"
+				} else {
+					labeled[i] = w
+				}
+			}
+			switch {
+			case largePrint:
+				fmt.Fprint(out, display.LargePrint(labeled))
+			case screenReader:
+				fmt.Fprint(out, display.List(labeled))
+			default:
+				fmt.Fprint(out, display.Columns(labeled, 6))
+			}
+			fmt.Fprintln(out, "(* marks less common words)")
+		case "hint", "nudge", "reveal":
+			if noHints {
+				fmt.Fprintln(out, i18n.T(lang, "hints_disabled"))
+				continue
+			}
+			level := letterboxed.HintNudge
+			switch line {
+			case "hint":
+				level = letterboxed.HintFirstLetter
+			case "reveal":
+				level = letterboxed.HintReveal
+			}
+			if msg, ok := letterboxed.HintAtLevel(dict, p, nil, level); ok {
+				fmt.Fprintln(out, msg)
+			} else {
+				fmt.Fprintln(out, i18n.T(lang, "no_solutions"))
+			}
+		case "stats":
+			chains, stats := letterboxed.SolveWithStats(dict, p, 3)
+			fmt.Fprintf(out, "%d solutions, %d candidate words, %d chains explored\n",
+				len(chains), stats.WordsConsidered, stats.ChainsExplored)
+		case "missing":
+			tried := session.Words()
+			if uncovered := letterboxed.UncoveredLetters(p, tried); uncovered != "" {
+				fmt.Fprintf(out, "still need to cover: %s\n", uncovered)
+			} else {
+				fmt.Fprintln(out, "all letters covered")
+			}
+			missing := letterboxed.MissingWords(dict, p, tried)
+			fmt.Fprintf(out, "%d playable words not yet tried\n", len(missing))
+		case "heatmap":
+			heatmap := letterboxed.SideTransitionHeatmap(dict, p)
+			for from := range heatmap {
+				fmt.Fprintf(out, "side %d ->", from+1)
+				for to, count := range heatmap[from] {
+					fmt.Fprintf(out, " side%d:%d", to+1, count)
+				}
+				fmt.Fprintln(out)
+			}
+		case "letters":
+			for _, li := range letterboxed.ImportanceReport(dict, p) {
+				fmt.Fprintf(out, "%c: %d words\n", li.Letter, li.WordCount)
+			}
+		case "rules":
+			fmt.Fprint(out, gameRules)
+		case "longest":
+			for _, w := range letterboxed.LongestWords(dict, p, 10) {
+				fmt.Fprintf(out, "%s (%d)\n", w, len(w))
+			}
+		case "tradeoffs":
+			front := letterboxed.ParetoFront(letterboxed.Solve(dict, p, 3))
+			for _, chain := range front {
+				score := letterboxed.ScoreChain(chain)
+				fmt.Fprintf(out, "%s (%d words, %d letters, %d obscure)\n",
+					strings.Join(chain, " -> "), score.WordCount, score.TotalLetters, score.ObscurityScore)
+			}
+		case "play":
+			runInteractivePlay(scanner, out, dict, p)
+		case "finish":
+			if noHints {
+				fmt.Fprintln(out, i18n.T(lang, "hints_disabled"))
+				continue
+			}
+			candidates := letterboxed.CompletionCandidates(dict, p, session.Words())
+			if len(candidates) == 0 {
+				fmt.Fprintln(out, "no single word finishes the puzzle from here")
+			} else {
+				fmt.Fprintln(out, strings.Join(candidates, ", "))
+			}
+		case "bridges":
+			counts := letterboxed.BridgeLetterCounts(dict, p)
+			if len(counts) == 0 {
+				fmt.Fprintln(out, "no two-word solutions found")
+				continue
+			}
+			letters := make([]byte, 0, len(counts))
+			for letter := range counts {
+				letters = append(letters, letter)
+			}
+			sort.Slice(letters, func(i, j int) bool { return counts[letters[i]] > counts[letters[j]] })
+			for _, letter := range letters {
+				fmt.Fprintf(out, "%c: %d\n", letter, counts[letter])
+			}
+		case "crosscheck":
+			result := letterboxed.CrossCheckSolve(dict, p, 3)
+			if result.Agrees {
+				fmt.Fprintf(out, "ok: %d solutions, all strategies agree\n", result.SolveCount)
+			} else {
+				fmt.Fprintf(out, "MISMATCH: solve=%d count=%d iddfs found some=%v\n",
+					result.SolveCount, result.CountResult, result.IDDFSFoundSome)
+			}
+		case "review":
+			if longest, ok := session.LongestPause(); ok {
+				fmt.Fprintf(out, "longest pause was %s, before entering %q\n", longest.Before.Round(time.Millisecond), longest.Word)
+			} else {
+				fmt.Fprintln(out, i18n.T(lang, "review_insufficient"))
+			}
+		default:
+			if word, ok := strings.CutPrefix(line, "add "); ok {
+				dict.AddWord(word)
+				fmt.Fprintf(out, "added %q\n", word)
+				continue
+			}
+			if word, ok := strings.CutPrefix(line, "remove "); ok {
+				dict.RemoveWord(word)
+				fmt.Fprintf(out, "removed %q\n", word)
+				continue
+			}
+			if rest, ok := strings.CutPrefix(line, "show "); ok {
+				idx, err := strconv.Atoi(strings.TrimSpace(rest))
+				if err != nil || idx < 1 {
+					fmt.Fprintln(out, "usage: show <solution-index>")
+					continue
+				}
+				chains := letterboxed.Solve(dict, p, 3)
+				if idx > len(chains) {
+					fmt.Fprintln(out, "no such solution")
+					continue
+				}
+				animateSolution(out, p, chains[idx-1], 150*time.Millisecond)
+				continue
+			}
+			if path, ok := strings.CutPrefix(line, "record "); ok {
+				rec = recording.NewRecorder(out)
+				out = rec
+				recordPath = path
+				fmt.Fprintf(out, "recording session to %s\n", path)
+				continue
+			}
+			if path, ok := strings.CutPrefix(line, "export image "); ok {
+				if err := exportImage(path, p, letterboxed.Solve(dict, p, 3)); err != nil {
+					fmt.Fprintln(out, err)
+				} else {
+					fmt.Fprintf(out, "wrote board image to %s\n", path)
+				}
+				continue
+			}
+			if path, ok := strings.CutPrefix(line, "export "); ok {
+				if err := session.ExportVocabulary(path); err != nil {
+					fmt.Fprintln(out, err)
+				} else {
+					fmt.Fprintf(out, "wrote %d words to %s\n", len(session.Words()), path)
+				}
+				continue
+			}
+			if word, ok := strings.CutPrefix(line, "type "); ok {
+				simulateTypingFeedback(out, dict, p, word)
+				continue
+			}
+			if word, ok := strings.CutPrefix(line, "partner "); ok {
+				word = strings.ToLower(strings.TrimSpace(word))
+				partners := letterboxed.FindPartners(dict, p, word)
+				if len(partners) == 0 {
+					fmt.Fprintln(out, "no two-word solution partners that word")
+				} else {
+					fmt.Fprintln(out, strings.Join(partners, ", "))
+				}
+				continue
+			}
+			if word, ok := strings.CutPrefix(line, "define "); ok {
+				def, err := definitions.Lookup(word)
+				if err != nil {
+					fmt.Fprintln(out, err)
+				} else {
+					fmt.Fprintln(out, def)
+				}
+				continue
+			}
+			if reason := letterboxed.ExplainInvalid(dict, p, line); reason != "" {
+				fmt.Fprintln(out, "invalid:", reason)
+			} else {
+				bus.Publish(events.Event{Type: events.WordGuessed, Data: line})
+				fmt.Fprintln(out, true)
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// runInteractivePlay steps through building a solution chain one word at a
+// time, keeping a stack of letterboxed.GameState snapshots so 'undo' can
+// always pop back to an earlier point and 'hint' can run a search against
+// the current snapshot in the background without racing further play,
+// since GameState is an immutable value rather than something play
+// mutates in place.
+func runInteractivePlay(scanner *bufio.Scanner, out io.Writer, dict *letterboxed.Dictionary, p letterboxed.Puzzle) {
+	history := []letterboxed.GameState{letterboxed.NewGameState()}
+
+	fmt.Fprintln(out, "play mode: enter a word, 'undo' to pop back one word, 'hint' for a finishing word, 'done' to stop")
+	for {
+		state := history[len(history)-1]
+		if state.Solved(p) {
+			fmt.Fprintln(out, "solved:", strings.Join(state.Words(), " -> "))
+			return
+		}
+
+		fmt.Fprint(out, "play> ")
+		if !scanner.Scan() {
+			return
+		}
+		line := strings.TrimSpace(scanner.Text())
+		switch line {
+		case "":
+			continue
+		case "done", "quit":
+			return
+		case "undo":
+			if len(history) == 1 {
+				fmt.Fprintln(out, "nothing to undo")
+				continue
+			}
+			history = history[:len(history)-1]
+		case "hint":
+			result := make(chan []string, 1)
+			go func(snapshot letterboxed.GameState) {
+				result <- letterboxed.CompletionCandidates(dict, p, snapshot.Words())
+			}(state)
+			if candidates := <-result; len(candidates) > 0 {
+				fmt.Fprintln(out, "could finish with:", strings.Join(candidates, ", "))
+			} else {
+				fmt.Fprintln(out, "no single word finishes it from here")
+			}
+		default:
+			if reason := letterboxed.ExplainInvalid(dict, p, line); reason != "" {
+				fmt.Fprintln(out, "invalid:", reason)
+				continue
+			}
+			history = append(history, state.Play(strings.ToLower(line)))
+		}
+	}
+}
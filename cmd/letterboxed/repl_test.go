@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aWarmWalrus/nyt-games/internal/i18n"
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// update regenerates the golden files instead of comparing against them,
+// for when a REPL session's output intentionally changes. Run with
+// `go test ./cmd/letterboxed -run TestREPLSessions -update`.
+var update = flag.Bool("update", false, "update golden files")
+
+// TestREPLSessions drives the REPL with a scripted sequence of input lines
+// and compares the transcript against a golden file, so a change to any
+// command's output is visible as a diff instead of silently shipping.
+func TestREPLSessions(t *testing.T) {
+	sessions := []struct {
+		name  string
+		input string
+	}{
+		{"basic", "cab\nbead\nrules\nletters\nquit\n"},
+		{"unknown_command", "stats\nbogus\nrules\nquit\n"},
+	}
+
+	// A tiny, hand-picked dictionary keeps the transcript fully
+	// deterministic instead of depending on the embedded word list.
+	p := letterboxed.NewPuzzle([4]string{"abc", "def", "ghi", "jkl"})
+	dict := letterboxed.NewDictionary("bead\n")
+
+	for _, tc := range sessions {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			repl(strings.NewReader(tc.input), &out, dict, p, 0, false, nil, "", false, false, i18n.English)
+
+			golden := filepath.Join("testdata", tc.name+".golden")
+			if *update {
+				if err := os.WriteFile(golden, out.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != string(want) {
+				t.Errorf("session %q transcript mismatch:\ngot:\n%s\nwant:\n%s", tc.name, out.String(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package main
+
+// gameRules is the built-in, detailed explanation of Letter Boxed's rules,
+// shown by the 'rules' REPL command for players who don't already know
+// the game.
+const gameRules = `Letter Boxed
+
+Twelve letters are arranged on the four sides of a box, three per side.
+Build words by tracing a path from letter to letter:
+
+  - Each word must be at least three letters long.
+  - Consecutive letters in a word cannot come from the same side of the
+    box (but it's fine to reuse a side later in the word).
+  - The last letter of each word becomes the first letter of the next.
+  - The goal is to use every one of the twelve letters at least once,
+    across as few words as possible. The daily puzzle is designed to
+    have a solution in as few as one or two words.
+
+Letters may be reused across words and within a single word.
+`
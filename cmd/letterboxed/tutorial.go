@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/i18n"
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// runTutorial walks a new player through a fixed practice puzzle step by
+// step: explaining the rules, having them enter a word, and confirming
+// whether it was accepted, before handing off to the normal REPL on the
+// same puzzle.
+func runTutorial(scanner *bufio.Scanner) {
+	fmt.Print(gameRules)
+
+	p := letterboxed.NewPuzzle([4]string{"abc", "def", "ghi", "jkl"})
+	dict := letterboxed.DefaultDictionary()
+	dict.AddWord("cab")
+	dict.AddWord("bead")
+
+	fmt.Printf("\npractice puzzle: %s\n", p.String())
+	fmt.Println("try entering \"bead\": it starts on side 1, moves to side 2, back to side 1, then to side 2 again")
+	fmt.Print("> ")
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" {
+			fmt.Print("> ")
+			continue
+		}
+		if msg := letterboxed.ExplainInvalid(dict, p, word); msg != "" {
+			fmt.Println(msg)
+			fmt.Print("> ")
+			continue
+		}
+		fmt.Printf("%q is valid! Letter Boxed words always work this way.\n", word)
+		break
+	}
+
+	fmt.Println("\nthat's the whole game: chain words together until every letter on the box")
+	fmt.Println("has been used. starting the REPL on this same practice puzzle now.")
+	repl(os.Stdin, os.Stdout, dict, p, 0, false, nil, "", false, false, i18n.English)
+}
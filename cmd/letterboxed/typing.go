@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// simulateTypingFeedback replays word letter by letter through a
+// letterboxed.TrieCursor and prints the live feedback a keystroke-driven
+// input widget would show as each letter arrives: whether the prefix
+// typed so far is still viable and a sample of its best completions.
+//
+// This REPL reads whole lines, not raw keystrokes, so there's no terminal
+// input widget to hook a cursor into; "type <word>" simulates the feed one
+// letter at a time from an already-typed word instead.
+func simulateTypingFeedback(out io.Writer, dict *letterboxed.Dictionary, p letterboxed.Puzzle, word string) {
+	word = strings.ToLower(word)
+	cursor := letterboxed.NewTrieCursor(dict, p)
+	for i := 0; i < len(word); i++ {
+		if !cursor.Advance(word[i]) {
+			fmt.Fprintf(out, "%-12s dead end\n", word[:i+1])
+			return
+		}
+		status := "viable"
+		if cursor.IsWord() {
+			status = "valid word"
+		}
+		completions := cursor.Completions(5)
+		fmt.Fprintf(out, "%-12s %s, completions: %s\n", word[:i+1], status, strings.Join(completions, ", "))
+	}
+}
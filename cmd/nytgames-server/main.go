@@ -0,0 +1,126 @@
+// Command nytgames-server serves the Letter Boxed solver over HTTP.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+	"github.com/aWarmWalrus/nyt-games/internal/server"
+)
+
+// namedDictFlag collects repeated -extra-dict=name=path flags.
+type namedDictFlag map[string]string
+
+func (f namedDictFlag) String() string {
+	return fmt.Sprint(map[string]string(f))
+}
+
+func (f namedDictFlag) Set(value string) error {
+	name, path, ok := strings.Cut(value, "=")
+	if !ok {
+		return fmt.Errorf("expected name=path, got %q", value)
+	}
+	f[name] = path
+	return nil
+}
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	apiKeysFlag := flag.String("api-keys", "", "comma-separated list of valid API keys")
+	rps := flag.Int("rate-limit", 60, "requests per minute allowed per API key")
+	sqliteDict := flag.String("sqlite-dict", "", "load the dictionary from a SQLite database (a 'words' table with a 'word' column) instead of the embedded word list")
+	dictFile := flag.String("dict-file", "", "load the dictionary from a newline-separated word list file instead of the embedded word list")
+	watchDict := flag.Bool("watch-dict", false, "with --dict-file, poll it for changes and hot-reload the dictionary, swapping it in without dropping requests")
+	leaderboardDB := flag.String("leaderboard-db", "", "enable the leaderboard API and page, backed by a SQLite database at this path")
+	embargoUntil := flag.String("embargo-until", "", "withhold full solutions for the current day's daily puzzle until this local time (HH:MM), returning a hint instead")
+	extraDicts := make(namedDictFlag)
+	flag.Var(extraDicts, "extra-dict", "name=path for an additional word list file, selectable per request via ?dict=name (repeatable)")
+	adminKeysFlag := flag.String("admin-keys", "", "comma-separated list of keys allowed to call the admin dictionary override API; empty disables it")
+	solveCacheSize := flag.Int("solve-cache", 0, "cache up to this many solve responses (0 disables caching)")
+	flag.Parse()
+
+	apiKeys := make(map[string]bool)
+	for _, k := range strings.Split(*apiKeysFlag, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			apiKeys[k] = true
+		}
+	}
+
+	dict := letterboxed.DefaultDictionary()
+	switch {
+	case *sqliteDict != "":
+		loaded, err := letterboxed.NewDictionaryFromSQLite(*sqliteDict)
+		if err != nil {
+			log.Fatalf("nytgames-server: %v", err)
+		}
+		dict = loaded
+	case *dictFile != "":
+		loaded, err := letterboxed.NewDictionaryFromFile(*dictFile)
+		if err != nil {
+			log.Fatalf("nytgames-server: %v", err)
+		}
+		dict = loaded
+	}
+	srv := server.New(dict, apiKeys, *rps)
+
+	for name, path := range extraDicts {
+		loaded, err := letterboxed.NewDictionaryFromFile(path)
+		if err != nil {
+			log.Fatalf("nytgames-server: load extra dictionary %q: %v", name, err)
+		}
+		srv.SetNamedDictionary(name, loaded)
+	}
+
+	if *watchDict {
+		if *dictFile == "" {
+			log.Fatal("nytgames-server: --watch-dict requires --dict-file")
+		}
+		go func() {
+			err := letterboxed.WatchDictionaryFile(*dictFile, 5*time.Second, nil, func(reloaded *letterboxed.Dictionary) {
+				log.Printf("nytgames-server: reloaded dictionary from %s (%d words)", *dictFile, reloaded.Len())
+				srv.SetDictionary(reloaded)
+			})
+			if err != nil {
+				log.Printf("nytgames-server: watch dictionary: %v", err)
+			}
+		}()
+	}
+
+	if *embargoUntil != "" {
+		var hour, minute int
+		if _, err := fmt.Sscanf(*embargoUntil, "%d:%d", &hour, &minute); err != nil {
+			log.Fatalf("nytgames-server: invalid --embargo-until %q: %v", *embargoUntil, err)
+		}
+		srv.SetEmbargo(hour, minute)
+	}
+
+	if *adminKeysFlag != "" {
+		adminKeys := make(map[string]bool)
+		for _, k := range strings.Split(*adminKeysFlag, ",") {
+			if k = strings.TrimSpace(k); k != "" {
+				adminKeys[k] = true
+			}
+		}
+		srv.EnableAdminAPI(adminKeys)
+	}
+
+	if *solveCacheSize > 0 {
+		srv.SetSolveCache(*solveCacheSize)
+	}
+
+	if *leaderboardDB != "" {
+		lb, err := server.NewLeaderboard(*leaderboardDB)
+		if err != nil {
+			log.Fatalf("nytgames-server: %v", err)
+		}
+		srv.SetLeaderboard(lb)
+	}
+
+	fmt.Printf("listening on %s\n", *addr)
+	log.Fatal(http.ListenAndServe(*addr, srv))
+}
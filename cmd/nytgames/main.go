@@ -0,0 +1,81 @@
+// Command nytgames is a generic CLI front end for any game registered
+// through the internal/plugin registry: pick one with -game, hand it a
+// puzzle spec in that game's own format, and play it guess by guess from
+// stdin. Letter Boxed has its own dedicated, feature-rich CLI
+// (cmd/letterboxed); this is the shared scaffolding every other game
+// plugs into instead of each needing its own command.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	_ "github.com/aWarmWalrus/nyt-games/internal/connections"
+	_ "github.com/aWarmWalrus/nyt-games/internal/crossword"
+	_ "github.com/aWarmWalrus/nyt-games/internal/digits"
+	"github.com/aWarmWalrus/nyt-games/internal/plugin"
+	_ "github.com/aWarmWalrus/nyt-games/internal/spellingbee"
+	_ "github.com/aWarmWalrus/nyt-games/internal/strands"
+	_ "github.com/aWarmWalrus/nyt-games/internal/sudoku"
+	_ "github.com/aWarmWalrus/nyt-games/internal/wordle"
+)
+
+func main() {
+	gameName := flag.String("game", "", "which registered game to play (see -list)")
+	list := flag.Bool("list", false, "list registered games and exit")
+	spec := flag.String("puzzle", "", "puzzle spec, in the selected game's own format")
+	flag.Parse()
+
+	if *list {
+		for _, name := range plugin.Names() {
+			fmt.Println(name)
+		}
+		return
+	}
+
+	game, ok := plugin.Lookup(*gameName)
+	if !ok {
+		log.Fatalf("nytgames: unknown game %q (run with -list to see registered games)", *gameName)
+	}
+	puzzle, err := game.NewPuzzle(*spec)
+	if err != nil {
+		log.Fatalf("nytgames: %v", err)
+	}
+	play(game, puzzle, os.Stdin, os.Stdout)
+}
+
+// play drives game with guesses read one per line from in, printing each
+// guess's result to out and stopping once the puzzle is solved or input
+// runs out.
+func play(game plugin.Game, puzzle any, in io.Reader, out io.Writer) {
+	fmt.Fprintf(out, "playing %s — enter guesses one per line, 'quit' to exit\n", game.Name())
+	var guesses []string
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, "> ")
+		if !scanner.Scan() {
+			return
+		}
+		guess := strings.TrimSpace(scanner.Text())
+		if guess == "" {
+			continue
+		}
+		if guess == "quit" {
+			return
+		}
+
+		guesses = append(guesses, guess)
+		_, message := game.CheckGuess(puzzle, guess)
+		fmt.Fprintln(out, message)
+
+		if game.Solved(puzzle, guesses) {
+			fmt.Fprintln(out, "solved!")
+			return
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Package boardimage renders a Letter Boxed puzzle (and optionally one or
+// more solutions' chords) as an image, for sharing a puzzle or a solve on
+// social media. It's a small pure-Go renderer: SVG output needs nothing
+// but text, and PNG output uses only the standard library's image/draw.
+package boardimage
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// Point is a position in the image, in pixels.
+type Point struct {
+	X, Y float64
+}
+
+// Layout places a puzzle's twelve letters evenly around the perimeter of a
+// size x size square, three per side: Sides[0] across the top, Sides[1]
+// down the right, Sides[2] across the bottom (right to left), and
+// Sides[3] up the left, matching the real game's box shape.
+func Layout(p letterboxed.Puzzle, size float64) map[byte]Point {
+	margin := size * 0.15
+	span := size - 2*margin
+	step := span / 3
+
+	points := make(map[byte]Point, 12)
+	top, right, bottom, left := p.Sides[0], p.Sides[1], p.Sides[2], p.Sides[3]
+	for i := 0; i < 3; i++ {
+		points[top[i]] = Point{X: margin + step*(float64(i)+0.5), Y: margin}
+		points[right[i]] = Point{X: size - margin, Y: margin + step*(float64(i)+0.5)}
+		points[bottom[i]] = Point{X: size - margin - step*(float64(i)+0.5), Y: size - margin}
+		points[left[i]] = Point{X: margin, Y: size - margin - step*(float64(i)+0.5)}
+	}
+	return points
+}
+
+var chordColors = []string{"#d62728", "#1f77b4", "#2ca02c", "#9467bd", "#ff7f0e"}
+
+// RenderSVG renders the puzzle as an SVG document of the given size,
+// labeling each letter and, for each chain in chains, drawing a chord
+// between every pair of consecutive letters (a different color per chain,
+// cycling if there are more chains than colors).
+func RenderSVG(p letterboxed.Puzzle, chains [][]string, size float64) string {
+	points := Layout(p, size)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%g" height="%g" viewBox="0 0 %g %g">`+"\n", size, size, size, size)
+	fmt.Fprintf(&b, `<rect width="%g" height="%g" fill="white"/>`+"\n", size, size)
+
+	for i, chain := range chains {
+		color := chordColors[i%len(chordColors)]
+		path := chainLetters(chain)
+		for j := 1; j < len(path); j++ {
+			from, to := points[path[j-1]], points[path[j]]
+			fmt.Fprintf(&b, `<line x1="%g" y1="%g" x2="%g" y2="%g" stroke="%s" stroke-width="2"/>`+"\n",
+				from.X, from.Y, to.X, to.Y, color)
+		}
+	}
+
+	for letter, pt := range points {
+		fmt.Fprintf(&b, `<circle cx="%g" cy="%g" r="14" fill="#eee" stroke="black"/>`+"\n", pt.X, pt.Y)
+		fmt.Fprintf(&b, `<text x="%g" y="%g" text-anchor="middle" dominant-baseline="central">%s</text>`+"\n",
+			pt.X, pt.Y, strings.ToUpper(string(letter)))
+	}
+
+	b.WriteString("</svg>\n")
+	return b.String()
+}
+
+// RenderPNG rasterizes the puzzle (and chains' chords) the same way as
+// RenderSVG, but without letter labels: drawing text onto a raster image
+// would need a font-rendering dependency this module doesn't otherwise
+// need, so letters are left to the caller's image caption instead.
+func RenderPNG(p letterboxed.Puzzle, chains [][]string, size int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+
+	points := Layout(p, float64(size))
+	for i, chain := range chains {
+		col := chordColor(i)
+		path := chainLetters(chain)
+		for j := 1; j < len(path); j++ {
+			drawLine(img, points[path[j-1]], points[path[j]], col)
+		}
+	}
+	for _, pt := range points {
+		drawDot(img, pt, color.Black)
+	}
+	return img
+}
+
+func chordColor(i int) color.Color {
+	palette := []color.Color{
+		color.RGBA{R: 214, G: 39, B: 40, A: 255},
+		color.RGBA{R: 31, G: 119, B: 180, A: 255},
+		color.RGBA{R: 44, G: 160, B: 44, A: 255},
+		color.RGBA{R: 148, G: 103, B: 189, A: 255},
+		color.RGBA{R: 255, G: 127, B: 14, A: 255},
+	}
+	return palette[i%len(palette)]
+}
+
+// chainLetters flattens a word chain into the sequence of letters the
+// solver's path actually visits.
+func chainLetters(chain []string) []byte {
+	var letters []byte
+	for _, word := range chain {
+		letters = append(letters, word...)
+	}
+	return letters
+}
+
+// drawLine draws a straight line between from and to using Bresenham's
+// algorithm, since the stdlib has no line primitive.
+func drawLine(img *image.RGBA, from, to Point, c color.Color) {
+	x0, y0 := int(from.X), int(from.Y)
+	x1, y1 := int(to.X), int(to.Y)
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := sign(x1-x0), sign(y1-y0)
+	err := dx + dy
+	for {
+		img.Set(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			break
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// drawDot paints a small filled square at pt, standing in for a letter
+// marker on the raster image.
+func drawDot(img *image.RGBA, pt Point, c color.Color) {
+	cx, cy := int(pt.X), int(pt.Y)
+	const r = 4
+	for dy := -r; dy <= r; dy++ {
+		for dx := -r; dx <= r; dx++ {
+			img.Set(cx+dx, cy+dy, c)
+		}
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	default:
+		return 0
+	}
+}
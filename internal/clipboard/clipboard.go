@@ -0,0 +1,44 @@
+// Package clipboard copies text to the system clipboard by shelling out to
+// the platform's native clipboard utility, avoiding a cgo or third-party
+// dependency for something every desktop OS already ships a CLI tool for.
+package clipboard
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// Copy places text on the system clipboard. It returns an error if the
+// platform's clipboard utility isn't available (e.g. xclip/xsel missing on
+// a headless Linux box), since there's no cross-platform way to detect
+// that ahead of time other than trying.
+func Copy(text string) error {
+	cmd, err := clipboardCommand()
+	if err != nil {
+		return err
+	}
+	cmd.Stdin = bytes.NewBufferString(text)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("clipboard: %w", err)
+	}
+	return nil
+}
+
+func clipboardCommand() (*exec.Cmd, error) {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("pbcopy"), nil
+	case "windows":
+		return exec.Command("clip"), nil
+	default:
+		if path, err := exec.LookPath("xclip"); err == nil {
+			return exec.Command(path, "-selection", "clipboard"), nil
+		}
+		if path, err := exec.LookPath("xsel"); err == nil {
+			return exec.Command(path, "--clipboard", "--input"), nil
+		}
+		return nil, fmt.Errorf("clipboard: no clipboard utility found (install xclip or xsel)")
+	}
+}
@@ -0,0 +1,156 @@
+// Package connections implements grading for the NYT Connections puzzle:
+// sixteen words sorted into four groups of four by shared category.
+package connections
+
+// Group is one category: a name (not required for grading, but useful for
+// display) and the four words that belong to it.
+type Group struct {
+	Name  string
+	Words [4]string
+}
+
+// Puzzle holds the four answer groups for a Connections puzzle.
+type Puzzle struct {
+	Groups [4]Group
+}
+
+// groupOf returns the index of the group containing word, or -1 if word
+// isn't part of the puzzle.
+func (p Puzzle) groupOf(word string) int {
+	for i, g := range p.Groups {
+		for _, w := range g.Words {
+			if w == word {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// GradeResult reports whether a guessed set of four words is exactly one of
+// the answer groups, and if not, how many of the four share the guess's
+// most common group (the "one away" signal the real game shows).
+type GradeResult struct {
+	Correct     bool
+	GroupIndex  int
+	BestOverlap int
+}
+
+// Grade scores a single guess of four words against p.
+func Grade(p Puzzle, guess [4]string) GradeResult {
+	counts := make(map[int]int)
+	for _, w := range guess {
+		if gi := p.groupOf(w); gi >= 0 {
+			counts[gi]++
+		}
+	}
+
+	best, bestCount := -1, 0
+	for gi, count := range counts {
+		if count > bestCount {
+			best, bestCount = gi, count
+		}
+	}
+
+	return GradeResult{
+		Correct:     bestCount == 4,
+		GroupIndex:  best,
+		BestOverlap: bestCount,
+	}
+}
+
+// Archive is a history of previously solved Connections puzzles, used as
+// training data for a mistake predictor: looking at which words have
+// shared a group before reveals clustering patterns without needing to
+// know today's own answer groups.
+type Archive struct {
+	Puzzles []Puzzle
+}
+
+// wordPair is an unordered pair of words, used as a map key so that
+// co-occurrence counts don't depend on argument order.
+type wordPair [2]string
+
+// pairKey builds a wordPair with a and b in a canonical order.
+func pairKey(a, b string) wordPair {
+	if a > b {
+		a, b = b, a
+	}
+	return wordPair{a, b}
+}
+
+// coOccurrence counts, across every puzzle in the archive, how many times
+// each pair of words appeared together in the same answer group.
+func (a Archive) coOccurrence() map[wordPair]int {
+	counts := make(map[wordPair]int)
+	for _, p := range a.Puzzles {
+		for _, g := range p.Groups {
+			for i := 0; i < len(g.Words); i++ {
+				for j := i + 1; j < len(g.Words); j++ {
+					counts[pairKey(g.Words[i], g.Words[j])]++
+				}
+			}
+		}
+	}
+	return counts
+}
+
+// MistakePredictor flags likely "one-away" guesses using co-occurrence
+// patterns learned from an Archive of past puzzles, without ever looking
+// at the current puzzle's own answer groups.
+type MistakePredictor struct {
+	coOccurs map[wordPair]int
+}
+
+// Train builds a MistakePredictor from archive, so the co-occurrence data
+// is computed once and reused across many predictions.
+func Train(archive Archive) *MistakePredictor {
+	return &MistakePredictor{coOccurs: archive.coOccurrence()}
+}
+
+// linked reports whether a and b have ever shared a group in the training
+// archive.
+func (m *MistakePredictor) linked(a, b string) bool {
+	return m.coOccurs[pairKey(a, b)] > 0
+}
+
+// PredictMistake reports whether guess looks like the classic "one-away"
+// trap: three of its four words have all previously shared a group with
+// each other somewhere in the training archive, while the fourth has
+// never shared a group with any of them — the shape of a guess that looks
+// right on three words and wrong on the outlier.
+func (m *MistakePredictor) PredictMistake(guess [4]string) bool {
+	for odd := range guess {
+		others := make([]string, 0, 3)
+		for i, w := range guess {
+			if i != odd {
+				others = append(others, w)
+			}
+		}
+
+		mutuallyLinked := true
+		for i := 0; i < len(others) && mutuallyLinked; i++ {
+			for j := i + 1; j < len(others); j++ {
+				if !m.linked(others[i], others[j]) {
+					mutuallyLinked = false
+					break
+				}
+			}
+		}
+		if !mutuallyLinked {
+			continue
+		}
+
+		outlierLinked := false
+		for _, w := range others {
+			if m.linked(guess[odd], w) {
+				outlierLinked = true
+				break
+			}
+		}
+		if !outlierLinked {
+			return true
+		}
+	}
+	return false
+}
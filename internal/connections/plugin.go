@@ -0,0 +1,90 @@
+package connections
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/plugin"
+)
+
+func init() {
+	plugin.Register(gamePlugin{})
+}
+
+// gamePlugin adapts Connections to the plugin.Game interface.
+type gamePlugin struct{}
+
+// Name identifies this game for the --game=connections CLI flag.
+func (gamePlugin) Name() string { return "connections" }
+
+// NewPuzzle parses a spec of four semicolon-separated groups, each a
+// name and its four words joined by colons, e.g.
+// "FRUIT:apple,pear,plum,kiwi;COLORS:red,blue,green,gold;...".
+func (gamePlugin) NewPuzzle(spec string) (any, error) {
+	groupSpecs := strings.Split(spec, ";")
+	if len(groupSpecs) != 4 {
+		return nil, fmt.Errorf("connections: want four ;-separated groups, got %d", len(groupSpecs))
+	}
+	var p Puzzle
+	for i, gs := range groupSpecs {
+		name, wordsPart, ok := strings.Cut(gs, ":")
+		if !ok {
+			return nil, fmt.Errorf("connections: group %q missing a \"name:words\" colon", gs)
+		}
+		words := strings.Split(wordsPart, ",")
+		if len(words) != 4 {
+			return nil, fmt.Errorf("connections: group %q needs exactly four words", name)
+		}
+		var g Group
+		g.Name = name
+		for j, w := range words {
+			g.Words[j] = strings.ToLower(strings.TrimSpace(w))
+		}
+		p.Groups[i] = g
+	}
+	return &p, nil
+}
+
+// CheckGuess grades a guess of four comma-separated words against p.
+func (gamePlugin) CheckGuess(puzzleAny any, guess string) (correct bool, message string) {
+	p := puzzleAny.(*Puzzle)
+	words := strings.Split(guess, ",")
+	if len(words) != 4 {
+		return false, "guesses must be four comma-separated words"
+	}
+	var four [4]string
+	for i, w := range words {
+		four[i] = strings.ToLower(strings.TrimSpace(w))
+	}
+
+	result := Grade(*p, four)
+	switch {
+	case result.Correct:
+		return true, fmt.Sprintf("correct: %s", p.Groups[result.GroupIndex].Name)
+	case result.BestOverlap == 3:
+		return false, "one away..."
+	default:
+		return false, fmt.Sprintf("not a group (best overlap: %d)", result.BestOverlap)
+	}
+}
+
+// Solved reports whether every one of p's four groups has been guessed
+// exactly, across the full guess history.
+func (gamePlugin) Solved(puzzleAny any, guesses []string) bool {
+	p := puzzleAny.(*Puzzle)
+	solved := make(map[int]bool, 4)
+	for _, guess := range guesses {
+		words := strings.Split(guess, ",")
+		if len(words) != 4 {
+			continue
+		}
+		var four [4]string
+		for i, w := range words {
+			four[i] = strings.ToLower(strings.TrimSpace(w))
+		}
+		if result := Grade(*p, four); result.Correct {
+			solved[result.GroupIndex] = true
+		}
+	}
+	return len(solved) == 4
+}
@@ -0,0 +1,80 @@
+// Package crossword holds crossword-solving helpers: clue lookup and grid
+// rendering.
+package crossword
+
+import (
+	"database/sql"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+// Clue is one clue/answer pair from a clue database.
+type Clue struct {
+	Text   string
+	Answer string
+}
+
+// ClueDatabase looks up candidate answers for a clue from a SQLite-backed
+// clue corpus, the same integration pattern letterboxed.NewDictionaryFromSQLite
+// uses for swapping in word lists without rebuilding the binary.
+type ClueDatabase struct {
+	db *sql.DB
+}
+
+// OpenClueDatabase opens a SQLite database at path containing a "clues"
+// table with "text" and "answer" text columns.
+func OpenClueDatabase(path string) (*ClueDatabase, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	return &ClueDatabase{db: db}, nil
+}
+
+// Close releases the underlying database connection.
+func (c *ClueDatabase) Close() error {
+	return c.db.Close()
+}
+
+// Lookup returns every answer on record for clues whose text matches text
+// exactly (case-insensitive), the way a solver cross-references a clue
+// against past puzzles to find likely answers.
+func (c *ClueDatabase) Lookup(text string) ([]Clue, error) {
+	rows, err := c.db.Query("SELECT text, answer FROM clues WHERE lower(text) = lower(?)", strings.TrimSpace(text))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var clues []Clue
+	for rows.Next() {
+		var cl Clue
+		if err := rows.Scan(&cl.Text, &cl.Answer); err != nil {
+			return nil, err
+		}
+		clues = append(clues, cl)
+	}
+	return clues, rows.Err()
+}
+
+// LookupByLength returns every clue on record whose answer is exactly n
+// letters long, for narrowing candidates once a grid constrains a slot's
+// length.
+func (c *ClueDatabase) LookupByLength(n int) ([]Clue, error) {
+	rows, err := c.db.Query("SELECT text, answer FROM clues WHERE length(answer) = ?", n)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var clues []Clue
+	for rows.Next() {
+		var cl Clue
+		if err := rows.Scan(&cl.Text, &cl.Answer); err != nil {
+			return nil, err
+		}
+		clues = append(clues, cl)
+	}
+	return clues, rows.Err()
+}
@@ -0,0 +1,63 @@
+package crossword
+
+import "strings"
+
+// blockCell marks a filled-in (unusable) cell in a grid, the crossword
+// convention for a black square.
+const blockCell = '#'
+
+// Grid is a crossword grid: cells hold a filled-in letter, blockCell for a
+// black square, or 0 for an empty (unfilled) white square.
+type Grid struct {
+	Rows int
+	Cols int
+	Cell [][]byte
+}
+
+// NewGrid builds an empty Grid of the given dimensions, with every cell
+// blank.
+func NewGrid(rows, cols int) Grid {
+	g := Grid{Rows: rows, Cols: cols, Cell: make([][]byte, rows)}
+	for i := range g.Cell {
+		g.Cell[i] = make([]byte, cols)
+	}
+	return g
+}
+
+// SetBlock marks (r, c) as a black square.
+func (g Grid) SetBlock(r, c int) {
+	g.Cell[r][c] = blockCell
+}
+
+// SetLetter fills (r, c) with letter.
+func (g Grid) SetLetter(r, c int, letter byte) {
+	g.Cell[r][c] = letter
+}
+
+// Render draws the grid as a terminal-friendly box, one character cell
+// wide with a border between rows and columns, blank squares shown as a
+// space and black squares as a solid block.
+func (g Grid) Render() string {
+	var b strings.Builder
+	rule := "+" + strings.Repeat("-", g.Cols*2-1) + "+\n"
+	b.WriteString(rule)
+	for _, row := range g.Cell {
+		b.WriteByte('|')
+		for i, c := range row {
+			switch c {
+			case 0:
+				b.WriteByte(' ')
+			case blockCell:
+				b.WriteString("█")
+			default:
+				b.WriteByte(c)
+			}
+			if i < len(row)-1 {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString("|\n")
+	}
+	b.WriteString(rule)
+	return b.String()
+}
@@ -0,0 +1,140 @@
+package crossword
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/plugin"
+)
+
+func init() {
+	plugin.Register(gamePlugin{})
+}
+
+// slot is one numbered entry (e.g. "1A" or "14D") the player fills in, and
+// where its answer sits in the grid.
+type slot struct {
+	row, col int
+	down     bool
+	answer   string
+	filled   bool
+}
+
+// puzzle is a Grid plus the slots it's graded against, keyed by clue
+// number and direction (e.g. "1A", "14D").
+type puzzle struct {
+	grid  Grid
+	slots map[string]*slot
+}
+
+// gamePlugin adapts a crossword to the plugin.Game interface. ClueDatabase
+// remains a standalone helper a fuller solver can query; this adapter
+// grades filled-in answers against a fixed set of slots.
+type gamePlugin struct{}
+
+// Name identifies this game for the --game=crossword CLI flag.
+func (gamePlugin) Name() string { return "crossword" }
+
+// NewPuzzle parses a spec of "<rows>x<cols>;<id>:<row>,<col>,<A|D>,<answer>;...",
+// e.g. "5x5;1A:0,0,A,CAT;2D:0,0,D,COG". Cells a slot's answer passes
+// through are left blank in the grid until guessed correctly.
+func (gamePlugin) NewPuzzle(spec string) (any, error) {
+	dims, rest, ok := strings.Cut(spec, ";")
+	if !ok {
+		return nil, fmt.Errorf("crossword: want \"<rows>x<cols>;<id>:<row>,<col>,<A|D>,<answer>;...\", got %q", spec)
+	}
+	rowsStr, colsStr, ok := strings.Cut(dims, "x")
+	if !ok {
+		return nil, fmt.Errorf("crossword: bad dimensions %q, want \"<rows>x<cols>\"", dims)
+	}
+	rows, err := strconv.Atoi(rowsStr)
+	if err != nil {
+		return nil, fmt.Errorf("crossword: bad row count %q: %w", rowsStr, err)
+	}
+	cols, err := strconv.Atoi(colsStr)
+	if err != nil {
+		return nil, fmt.Errorf("crossword: bad column count %q: %w", colsStr, err)
+	}
+
+	p := &puzzle{grid: NewGrid(rows, cols), slots: make(map[string]*slot)}
+	for _, entry := range strings.Split(rest, ";") {
+		id, fields, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("crossword: entry %q missing an \"id:...\" colon", entry)
+		}
+		parts := strings.SplitN(fields, ",", 4)
+		if len(parts) != 4 {
+			return nil, fmt.Errorf("crossword: slot %q wants \"<row>,<col>,<A|D>,<answer>\"", id)
+		}
+		row, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("crossword: slot %q bad row %q: %w", id, parts[0], err)
+		}
+		col, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("crossword: slot %q bad col %q: %w", id, parts[1], err)
+		}
+		p.slots[strings.ToUpper(strings.TrimSpace(id))] = &slot{
+			row:    row,
+			col:    col,
+			down:   strings.EqualFold(parts[2], "D"),
+			answer: strings.ToUpper(strings.TrimSpace(parts[3])),
+		}
+	}
+	if len(p.slots) == 0 {
+		return nil, fmt.Errorf("crossword: want at least one slot, got %q", spec)
+	}
+	return p, nil
+}
+
+// fill writes s's answer into p's grid, letter by letter.
+func (p *puzzle) fill(s *slot) {
+	for i := 0; i < len(s.answer); i++ {
+		r, c := s.row, s.col
+		if s.down {
+			r += i
+		} else {
+			c += i
+		}
+		p.grid.SetLetter(r, c, s.answer[i])
+	}
+}
+
+// CheckGuess handles the reserved guess "show", which renders the grid as
+// filled in so far, or grades a guess of the form "<id> <word>" (e.g.
+// "1A cat") against the named slot's answer.
+func (gamePlugin) CheckGuess(puzzleAny any, guess string) (correct bool, message string) {
+	p := puzzleAny.(*puzzle)
+	guess = strings.TrimSpace(guess)
+	if strings.EqualFold(guess, "show") {
+		return false, p.grid.Render()
+	}
+
+	id, word, ok := strings.Cut(guess, " ")
+	if !ok {
+		return false, "guesses must be \"<id> <word>\", or \"show\" to render the grid"
+	}
+	id = strings.ToUpper(id)
+	s, ok := p.slots[id]
+	if !ok {
+		return false, fmt.Sprintf("no such slot %q", id)
+	}
+	if strings.ToUpper(strings.TrimSpace(word)) != s.answer {
+		return false, fmt.Sprintf("%s: not it", id)
+	}
+	s.filled = true
+	p.fill(s)
+	return true, fmt.Sprintf("%s: correct", id)
+}
+
+// Solved reports whether every slot has been filled in correctly.
+func (gamePlugin) Solved(puzzleAny any, guesses []string) bool {
+	p := puzzleAny.(*puzzle)
+	for _, s := range p.slots {
+		if !s.filled {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,56 @@
+// Package daemon runs the letterboxed solver as a long-lived process with a
+// warm, already-indexed dictionary, so repeated solves don't each pay the
+// cost of loading and trie-indexing the word list from scratch.
+package daemon
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// Serve accepts connections on a Unix domain socket at socketPath and
+// answers one puzzle per line: a request of "abc,def,ghi,jkl" is solved
+// against dict and the solutions are written back, one per line, followed
+// by a blank line.
+func Serve(socketPath string, dict *letterboxed.Dictionary) error {
+	ln, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("daemon: listen: %w", err)
+	}
+	defer ln.Close()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			log.Printf("daemon: accept: %v", err)
+			continue
+		}
+		go handleConn(conn, dict)
+	}
+}
+
+func handleConn(conn net.Conn, dict *letterboxed.Dictionary) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		sides, err := letterboxed.ParseSides(line)
+		if err != nil {
+			fmt.Fprintf(conn, "error: %v\n\n", err)
+			continue
+		}
+		p := letterboxed.NewPuzzle(sides)
+		for _, chain := range letterboxed.Solve(dict, p, 3) {
+			fmt.Fprintln(conn, strings.Join(chain, " -> "))
+		}
+		fmt.Fprintln(conn)
+	}
+}
@@ -0,0 +1,83 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// dailyPuzzleSummary is the payload posted to a webhook when a new daily
+// puzzle becomes available.
+type dailyPuzzleSummary struct {
+	Day       string    `json:"day"`
+	Sides     [4]string `json:"sides"`
+	Solutions int       `json:"solutions"`
+}
+
+// RunDailyScheduler blocks, waking at each local midnight to generate the
+// new daily puzzle, pre-solve it to count its solutions, and POST a summary
+// to webhookURL (skipped if empty). The solved chains are not kept or
+// cached; only their count goes into the summary. It only
+// returns if stop is closed.
+func RunDailyScheduler(dict *letterboxed.Dictionary, webhookURL string, stop <-chan struct{}) {
+	for {
+		wait := time.Until(nextLocalMidnight(time.Now()))
+		select {
+		case <-time.After(wait):
+			announceDailyPuzzle(dict, webhookURL)
+		case <-stop:
+			return
+		}
+	}
+}
+
+// nextLocalMidnight returns the next local-time midnight strictly after
+// now.
+func nextLocalMidnight(now time.Time) time.Time {
+	year, month, day := now.Date()
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+	return midnight.AddDate(0, 0, 1)
+}
+
+func announceDailyPuzzle(dict *letterboxed.Dictionary, webhookURL string) {
+	now := time.Now()
+	p, err := letterboxed.GenerateDaily(dict, now)
+	if err != nil {
+		log.Printf("daemon: generate daily puzzle: %v", err)
+		return
+	}
+	solutions := letterboxed.Solve(dict, p, 3)
+
+	summary := dailyPuzzleSummary{
+		Day:       now.Format("2006-01-02"),
+		Sides:     p.Sides,
+		Solutions: len(solutions),
+	}
+	if webhookURL == "" {
+		return
+	}
+	if err := postWebhook(webhookURL, summary); err != nil {
+		log.Printf("daemon: post daily puzzle webhook: %v", err)
+	}
+}
+
+func postWebhook(url string, summary dailyPuzzleSummary) error {
+	body, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("daemon: marshal webhook payload: %w", err)
+	}
+	resp, err := http.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("daemon: send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("daemon: webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
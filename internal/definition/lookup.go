@@ -0,0 +1,66 @@
+// Package definition looks up plain-English word definitions from a
+// dictionary API, for commands that want to show players what an unfamiliar
+// valid word actually means.
+package definition
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// apiBase is the free dictionary API used to resolve definitions. It's a
+// package var rather than a const so tests can point it at a local server.
+var apiBase = "https://api.dictionaryapi.dev/api/v2/entries/en"
+
+type entry struct {
+	Meanings []struct {
+		PartOfSpeech string `json:"partOfSpeech"`
+		Definitions  []struct {
+			Definition string `json:"definition"`
+		} `json:"definitions"`
+	} `json:"meanings"`
+}
+
+// Client looks up definitions over HTTP, with a bounded timeout so a single
+// slow lookup can't hang a caller indefinitely.
+type Client struct {
+	HTTP *http.Client
+}
+
+// NewClient returns a Client with a sane default timeout.
+func NewClient() *Client {
+	return &Client{HTTP: &http.Client{Timeout: 5 * time.Second}}
+}
+
+// Lookup returns the first definition found for word, in "(partOfSpeech)
+// definition" form.
+func (c *Client) Lookup(word string) (string, error) {
+	resp, err := c.HTTP.Get(apiBase + "/" + url.PathEscape(word))
+	if err != nil {
+		return "", fmt.Errorf("definition: lookup %q: %w", word, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("definition: no entry found for %q", word)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("definition: lookup %q: unexpected status %s", word, resp.Status)
+	}
+
+	var entries []entry
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return "", fmt.Errorf("definition: decode response for %q: %w", word, err)
+	}
+	for _, e := range entries {
+		for _, m := range e.Meanings {
+			for _, d := range m.Definitions {
+				return fmt.Sprintf("(%s) %s", m.PartOfSpeech, d.Definition), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("definition: no definition found for %q", word)
+}
@@ -0,0 +1,150 @@
+// Package digits solves the NYT Digits puzzle: combine six given numbers
+// with +, -, *, / to reach a target, using each number at most once.
+package digits
+
+import "fmt"
+
+// Op is one of the four arithmetic operators Digits allows.
+type Op byte
+
+const (
+	Add Op = '+'
+	Sub Op = '-'
+	Mul Op = '*'
+	Div Op = '/'
+)
+
+// Step is one operation in a solution: combining two available values
+// (by their current value, not original index, since intermediate results
+// become usable inputs too) into a new one.
+type Step struct {
+	Left, Right int
+	Op          Op
+	Result      int
+}
+
+// Solution is an ordered sequence of Steps that combines the starting
+// numbers down to a single value.
+type Solution struct {
+	Steps []Step
+	Value int
+}
+
+// apply evaluates op on a and b, reporting ok == false only for a division
+// by zero or a division that doesn't come out even, since Digits is
+// integer-only. Zero and negative results are allowed.
+func apply(a, b int, op Op) (int, bool) {
+	switch op {
+	case Add:
+		return a + b, true
+	case Sub:
+		return a - b, true
+	case Mul:
+		return a * b, true
+	case Div:
+		if b == 0 || a%b != 0 {
+			return 0, false
+		}
+		return a / b, true
+	}
+	return 0, false
+}
+
+// Solve searches for every way to combine numbers down to target, pairing
+// two available values at a time (in either order, since subtraction and
+// division aren't commutative) until one value remains.
+func Solve(numbers []int, target int) []Solution {
+	var solutions []Solution
+	var search func(values []int, steps []Step)
+	search = func(values []int, steps []Step) {
+		if len(values) == 1 {
+			if values[0] == target {
+				solutions = append(solutions, Solution{Steps: append([]Step(nil), steps...), Value: values[0]})
+			}
+			return
+		}
+		for i := 0; i < len(values); i++ {
+			for j := 0; j < len(values); j++ {
+				if i == j {
+					continue
+				}
+				rest := removeTwo(values, i, j)
+				for _, op := range [...]Op{Add, Sub, Mul, Div} {
+					result, ok := apply(values[i], values[j], op)
+					if !ok {
+						continue
+					}
+					search(append(rest, result), append(steps, Step{Left: values[i], Right: values[j], Op: op, Result: result}))
+				}
+			}
+		}
+	}
+	search(append([]int(nil), numbers...), nil)
+	return solutions
+}
+
+// removeTwo returns a copy of values with the elements at i and j removed.
+func removeTwo(values []int, i, j int) []int {
+	out := make([]int, 0, len(values)-2)
+	for k, v := range values {
+		if k != i && k != j {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// ClosestMiss finds the solution(s) among every reachable final value that
+// come closest to target without exactly matching it, for when no exact
+// solution exists.
+func ClosestMiss(numbers []int, target int) (best Solution, distance int, found bool) {
+	distance = -1
+	var search func(values []int, steps []Step)
+	search = func(values []int, steps []Step) {
+		if len(values) == 1 {
+			d := abs(values[0] - target)
+			if d != 0 && (distance == -1 || d < distance) {
+				distance = d
+				best = Solution{Steps: append([]Step(nil), steps...), Value: values[0]}
+				found = true
+			}
+			return
+		}
+		for i := 0; i < len(values); i++ {
+			for j := 0; j < len(values); j++ {
+				if i == j {
+					continue
+				}
+				rest := removeTwo(values, i, j)
+				for _, op := range [...]Op{Add, Sub, Mul, Div} {
+					result, ok := apply(values[i], values[j], op)
+					if !ok {
+						continue
+					}
+					search(append(rest, result), append(steps, Step{Left: values[i], Right: values[j], Op: op, Result: result}))
+				}
+			}
+		}
+	}
+	search(append([]int(nil), numbers...), nil)
+	return best, distance, found
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// Elegance scores a solution by how few steps it took: fewer steps (using
+// fewer of the six numbers) is considered a more elegant solve, matching
+// Digits' in-game star rating.
+func Elegance(s Solution) int {
+	return len(s.Steps)
+}
+
+// String renders a Step as an arithmetic expression, e.g. "6 + 4 = 10".
+func (s Step) String() string {
+	return fmt.Sprintf("%d %c %d = %d", s.Left, s.Op, s.Right, s.Result)
+}
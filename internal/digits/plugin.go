@@ -0,0 +1,95 @@
+package digits
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/plugin"
+)
+
+func init() {
+	plugin.Register(gamePlugin{})
+}
+
+// puzzle is a Digits round: the six (or fewer) starting numbers and the
+// target they're combined to reach.
+type puzzle struct {
+	numbers []int
+	target  int
+}
+
+// gamePlugin adapts Digits to the plugin.Game interface. A guess is the
+// player's claimed final value rather than a full step list; CheckGuess
+// surfaces Solve's most elegant solution (or ClosestMiss's distance, if the
+// guess misses the target) so the player can see how it's done.
+type gamePlugin struct{}
+
+// Name identifies this game for the --game=digits CLI flag.
+func (gamePlugin) Name() string { return "digits" }
+
+// NewPuzzle parses a spec of "<numbers, comma-separated> <target>", e.g.
+// "6,4,2,8,10,3 400".
+func (gamePlugin) NewPuzzle(spec string) (any, error) {
+	numsPart, targetPart, ok := strings.Cut(strings.TrimSpace(spec), " ")
+	if !ok {
+		return nil, fmt.Errorf("digits: want \"<numbers>,... <target>\", got %q", spec)
+	}
+	var numbers []int
+	for _, n := range strings.Split(numsPart, ",") {
+		v, err := strconv.Atoi(strings.TrimSpace(n))
+		if err != nil {
+			return nil, fmt.Errorf("digits: bad number %q: %w", n, err)
+		}
+		numbers = append(numbers, v)
+	}
+	target, err := strconv.Atoi(strings.TrimSpace(targetPart))
+	if err != nil {
+		return nil, fmt.Errorf("digits: bad target %q: %w", targetPart, err)
+	}
+	return &puzzle{numbers: numbers, target: target}, nil
+}
+
+// CheckGuess takes the player's claimed final value. If it matches the
+// target, the response includes the most elegant way Solve found to get
+// there; otherwise it reports the closest reachable miss.
+func (gamePlugin) CheckGuess(puzzleAny any, guess string) (correct bool, message string) {
+	p := puzzleAny.(*puzzle)
+	value, err := strconv.Atoi(strings.TrimSpace(guess))
+	if err != nil {
+		return false, "guesses must be a number"
+	}
+	if value != p.target {
+		_, distance, found := ClosestMiss(p.numbers, p.target)
+		if !found {
+			return false, "not it, and no combination reaches any value at all"
+		}
+		return false, fmt.Sprintf("not it (closest reachable miss is %d off)", distance)
+	}
+
+	solutions := Solve(p.numbers, p.target)
+	if len(solutions) == 0 {
+		return true, fmt.Sprintf("%d is the target, but no step sequence was found to show its work", p.target)
+	}
+	best := solutions[0]
+	for _, s := range solutions[1:] {
+		if Elegance(s) < Elegance(best) {
+			best = s
+		}
+	}
+	steps := make([]string, len(best.Steps))
+	for i, step := range best.Steps {
+		steps[i] = step.String()
+	}
+	return true, fmt.Sprintf("correct! %s", strings.Join(steps, "; "))
+}
+
+// Solved reports whether the most recent guess hit the target exactly.
+func (gamePlugin) Solved(puzzleAny any, guesses []string) bool {
+	p := puzzleAny.(*puzzle)
+	if len(guesses) == 0 {
+		return false
+	}
+	value, err := strconv.Atoi(strings.TrimSpace(guesses[len(guesses)-1]))
+	return err == nil && value == p.target
+}
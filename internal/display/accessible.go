@@ -0,0 +1,26 @@
+package display
+
+import "strings"
+
+// List renders items one per line instead of in columns, for screen
+// readers that announce a grid's layout rather than just its contents.
+func List(items []string) string {
+	var b strings.Builder
+	for _, item := range items {
+		b.WriteString(item)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// LargePrint renders items one per line in capital letters with wide
+// spacing between them, for players who find dense columns of lowercase
+// text hard to read.
+func LargePrint(items []string) string {
+	var b strings.Builder
+	for _, item := range items {
+		b.WriteString(strings.ToUpper(strings.Join(strings.Split(item, ""), " ")))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
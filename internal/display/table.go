@@ -0,0 +1,38 @@
+package display
+
+import "strings"
+
+// Columns lays items out left-aligned in a grid of perCol columns, each
+// column padded to the width of its widest entry, the way `ls` lays out a
+// directory listing.
+func Columns(items []string, perCol int) string {
+	if perCol < 1 {
+		perCol = 1
+	}
+	rows := (len(items) + perCol - 1) / perCol
+
+	colWidth := make([]int, perCol)
+	for i, item := range items {
+		col := i % perCol
+		if w := Width(item); w > colWidth[col] {
+			colWidth[col] = w
+		}
+	}
+
+	var b strings.Builder
+	for r := 0; r < rows; r++ {
+		for c := 0; c < perCol; c++ {
+			i := r*perCol + c
+			if i >= len(items) {
+				break
+			}
+			if c == perCol-1 || i == len(items)-1 {
+				b.WriteString(items[i])
+			} else {
+				b.WriteString(PadRight(items[i], colWidth[c]+2))
+			}
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
@@ -0,0 +1,76 @@
+// Package display holds terminal-rendering helpers shared across the game
+// CLIs: anything that measures or pads text for column alignment belongs
+// here rather than duplicated per command.
+package display
+
+import "unicode"
+
+// wideRanges lists the Unicode blocks that render as two terminal columns
+// wide in effectively every monospace terminal: CJK ideographs, Hangul,
+// kana, and fullwidth forms. It's not exhaustive of the East Asian Width
+// spec, but covers what's likely to actually show up in puzzle words or
+// clues.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F}, // Hangul Jamo
+	{0x2E80, 0xA4CF}, // CJK radicals through Yi
+	{0xAC00, 0xD7A3}, // Hangul syllables
+	{0xF900, 0xFAFF}, // CJK compatibility ideographs
+	{0xFF00, 0xFF60}, // fullwidth forms
+	{0xFFE0, 0xFFE6},
+}
+
+// runeWidth returns the terminal column width of r: 0 for combining marks,
+// 2 for wide East Asian characters, 1 otherwise.
+func runeWidth(r rune) int {
+	if r == 0 || unicode.In(r, unicode.Mn, unicode.Me) {
+		return 0
+	}
+	for _, rng := range wideRanges {
+		if r >= rng[0] && r <= rng[1] {
+			return 2
+		}
+	}
+	return 1
+}
+
+// Width returns the rendered terminal column width of s, which may differ
+// from both len(s) (bytes) and utf8.RuneCountInString(s) (code points)
+// once wide characters are involved.
+func Width(s string) int {
+	w := 0
+	for _, r := range s {
+		w += runeWidth(r)
+	}
+	return w
+}
+
+// PadRight pads s with spaces on the right until it's width columns wide,
+// measuring width the same way Width does. It never truncates: a string
+// already at or beyond width is returned unchanged.
+func PadRight(s string, width int) string {
+	pad := width - Width(s)
+	if pad <= 0 {
+		return s
+	}
+	b := make([]byte, 0, len(s)+pad)
+	b = append(b, s...)
+	for i := 0; i < pad; i++ {
+		b = append(b, ' ')
+	}
+	return string(b)
+}
+
+// TruncateToWidth shortens s so its rendered width doesn't exceed width,
+// respecting rune boundaries so a multi-byte character is never cut in
+// half.
+func TruncateToWidth(s string, width int) string {
+	w := 0
+	for i, r := range s {
+		rw := runeWidth(r)
+		if w+rw > width {
+			return s[:i]
+		}
+		w += rw
+	}
+	return s
+}
@@ -0,0 +1,52 @@
+// Package events provides a small publish/subscribe bus for game actions,
+// so subsystems like session review, notifications, or a future web UI can
+// react to what's happening without the REPL calling each of them
+// directly.
+package events
+
+import "sync"
+
+// Event is one thing that happened during play.
+type Event struct {
+	Type string
+	Data any
+}
+
+// Event type constants for the REPL's own actions.
+const (
+	WordGuessed   = "word_guessed"
+	PuzzleSolved  = "puzzle_solved"
+	HintRequested = "hint_requested"
+)
+
+// Handler reacts to a published Event.
+type Handler func(Event)
+
+// Bus fans out published events to every subscribed handler.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewBus returns an empty Bus.
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[string][]Handler)}
+}
+
+// Subscribe registers handler to run on every event of the given type.
+func (b *Bus) Subscribe(eventType string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Publish runs every handler subscribed to event.Type, synchronously and in
+// subscription order.
+func (b *Bus) Publish(event Event) {
+	b.mu.RLock()
+	handlers := b.handlers[event.Type]
+	b.mu.RUnlock()
+	for _, h := range handlers {
+		h(event)
+	}
+}
@@ -0,0 +1,121 @@
+// Package exactcover solves exact cover problems with a straightforward
+// recursive backtracking search (Knuth's Algorithm X, without the dancing
+// links optimization): given a universe of items and a set of candidate
+// subsets, find a selection of subsets that covers every item exactly
+// once. Several NYT puzzles reduce to this — Sudoku (cell/row/column/box
+// constraints) being the canonical example.
+package exactcover
+
+// Option is one candidate subset, identified by an opaque ID the caller
+// can map back to whatever it represents (a Sudoku digit placement, a
+// puzzle word placement, etc).
+type Option struct {
+	ID    int
+	Items []int
+}
+
+// Solve searches for a set of options whose Items partition universe
+// exactly: every item in 0..numItems-1 covered by precisely one chosen
+// option. It returns the first solution found, as the list of chosen
+// option IDs, or nil if none exists.
+func Solve(numItems int, options []Option) []int {
+	covered := make([]bool, numItems)
+	byItem := make([][]int, numItems)
+	for oi, opt := range options {
+		for _, item := range opt.Items {
+			byItem[item] = append(byItem[item], oi)
+		}
+	}
+
+	var chosen []int
+	var search func() bool
+	search = func() bool {
+		item := nextUncoveredItem(covered)
+		if item == -1 {
+			return true
+		}
+		for _, oi := range byItem[item] {
+			if !canPlace(options[oi], covered) {
+				continue
+			}
+			mark(options[oi], covered, true)
+			chosen = append(chosen, options[oi].ID)
+			if search() {
+				return true
+			}
+			chosen = chosen[:len(chosen)-1]
+			mark(options[oi], covered, false)
+		}
+		return false
+	}
+
+	if search() {
+		result := make([]int, len(chosen))
+		copy(result, chosen)
+		return result
+	}
+	return nil
+}
+
+// CountSolutions counts distinct exact covers of universe, stopping as
+// soon as it finds limit of them. Counting up to a small limit (2 is
+// enough to tell "unique" from "not unique") is much cheaper than
+// enumerating every solution when a caller only needs to know whether one
+// exists, since the search can abandon the remaining branches the moment
+// the limit is reached.
+func CountSolutions(numItems int, options []Option, limit int) int {
+	covered := make([]bool, numItems)
+	byItem := make([][]int, numItems)
+	for oi, opt := range options {
+		for _, item := range opt.Items {
+			byItem[item] = append(byItem[item], oi)
+		}
+	}
+
+	count := 0
+	var search func()
+	search = func() {
+		if count >= limit {
+			return
+		}
+		item := nextUncoveredItem(covered)
+		if item == -1 {
+			count++
+			return
+		}
+		for _, oi := range byItem[item] {
+			if count >= limit || !canPlace(options[oi], covered) {
+				continue
+			}
+			mark(options[oi], covered, true)
+			search()
+			mark(options[oi], covered, false)
+		}
+	}
+	search()
+	return count
+}
+
+func nextUncoveredItem(covered []bool) int {
+	for i, c := range covered {
+		if !c {
+			return i
+		}
+	}
+	return -1
+}
+
+func canPlace(opt Option, covered []bool) bool {
+	for _, item := range opt.Items {
+		if covered[item] {
+			return false
+		}
+	}
+	return true
+}
+
+func mark(opt Option, covered []bool, value bool) {
+	for _, item := range opt.Items {
+		covered[item] = value
+	}
+}
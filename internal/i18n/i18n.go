@@ -0,0 +1,50 @@
+// Package i18n holds the CLI's user-facing strings in translatable form,
+// so adding a language means adding a catalog entry rather than editing
+// call sites throughout the codebase.
+package i18n
+
+// Lang identifies one of the supported UI languages.
+type Lang string
+
+const (
+	English Lang = "en"
+	Spanish Lang = "es"
+)
+
+var catalogs = map[Lang]map[string]string{
+	English: {
+		"no_solutions":        "no completion found",
+		"hints_disabled":      "hints are disabled in this session",
+		"review_insufficient": "not enough guesses yet to review",
+	},
+	Spanish: {
+		"no_solutions":        "no se encontró ninguna solución",
+		"hints_disabled":      "las pistas están desactivadas en esta sesión",
+		"review_insufficient": "todavía no hay suficientes intentos para revisar",
+	},
+}
+
+// T returns the string for key in lang, falling back to English and then
+// to the key itself if no translation exists.
+func T(lang Lang, key string) string {
+	if catalog, ok := catalogs[lang]; ok {
+		if s, ok := catalog[key]; ok {
+			return s
+		}
+	}
+	if s, ok := catalogs[English][key]; ok {
+		return s
+	}
+	return key
+}
+
+// Parse maps a language code (e.g. from a --lang flag) to a Lang,
+// defaulting to English for anything unrecognized.
+func Parse(code string) Lang {
+	switch Lang(code) {
+	case Spanish:
+		return Spanish
+	default:
+		return English
+	}
+}
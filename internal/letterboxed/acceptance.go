@@ -0,0 +1,93 @@
+package letterboxed
+
+import "strings"
+
+// WordFeature is a shape of word ending that NYT's Letter Boxed dictionary
+// has historically been inconsistent about accepting.
+type WordFeature int
+
+const (
+	// FeaturePlural marks a simple trailing "-s" plural.
+	FeaturePlural WordFeature = iota
+	// FeatureIngForm marks a trailing "-ing" gerund/participle.
+	FeatureIngForm
+	// FeatureBritishSpelling marks a British-English spelling variant
+	// ("-our", "-ise", "-isation").
+	FeatureBritishSpelling
+)
+
+// ClassifyWord reports which heuristic features word exhibits.
+func ClassifyWord(word string) []WordFeature {
+	var features []WordFeature
+	if strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") {
+		features = append(features, FeaturePlural)
+	}
+	if strings.HasSuffix(word, "ing") {
+		features = append(features, FeatureIngForm)
+	}
+	if strings.HasSuffix(word, "our") || strings.HasSuffix(word, "ise") || strings.HasSuffix(word, "isation") {
+		features = append(features, FeatureBritishSpelling)
+	}
+	return features
+}
+
+// AcceptanceTrend tallies how many words in a dictionary exhibit each
+// heuristic feature.
+//
+// There's no offline source of NYT's actual accept/reject decisions, so
+// this approximates "what NYT tends to accept" by reporting what the
+// loaded dictionary already contains of each shape. It's meant for
+// auditing a dictionary against known NYT quirks, not as a prediction of
+// any specific word's fate.
+type AcceptanceTrend struct {
+	Plural          int
+	IngForm         int
+	BritishSpelling int
+	Total           int
+}
+
+// AnalyzeAcceptanceTrend tallies ClassifyWord across every word in d.
+func AnalyzeAcceptanceTrend(d *Dictionary) AcceptanceTrend {
+	var t AcceptanceTrend
+	for _, w := range d.words {
+		t.Total++
+		for _, f := range ClassifyWord(w) {
+			switch f {
+			case FeaturePlural:
+				t.Plural++
+			case FeatureIngForm:
+				t.IngForm++
+			case FeatureBritishSpelling:
+				t.BritishSpelling++
+			}
+		}
+	}
+	return t
+}
+
+// FilterByFeatures returns a new Dictionary containing only the words from
+// d that exhibit none of the given features: an optional heuristic
+// dictionary mode for players who want to practice against a stricter word
+// list (e.g. no British spellings) without hand-editing the word file.
+func FilterByFeatures(d *Dictionary, exclude ...WordFeature) *Dictionary {
+	excluded := make(map[WordFeature]bool, len(exclude))
+	for _, f := range exclude {
+		excluded[f] = true
+	}
+	filtered := &Dictionary{trie: NewTrie()}
+	for _, w := range d.words {
+		skip := false
+		for _, f := range ClassifyWord(w) {
+			if excluded[f] {
+				skip = true
+				break
+			}
+		}
+		if !skip {
+			filtered.trie.Insert(w)
+			filtered.words = append(filtered.words, w)
+		}
+	}
+	filtered.rebuildBloom()
+	return filtered
+}
@@ -0,0 +1,90 @@
+package letterboxed
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PuzzleDifficulty summarizes how one puzzle in an archive solved: its par
+// (the length of its shortest found solution, 0 if none was found within
+// the word limit the batch was solved with) and how many solutions exist
+// in total.
+type PuzzleDifficulty struct {
+	Puzzle        Puzzle
+	Par           int
+	SolutionCount int
+}
+
+// ArchiveReport summarizes a batch of solved puzzles for a blog-post style
+// write-up: difficulty puzzle by puzzle, the words that solve a puzzle all
+// by themselves (pangrams), and the average par across the archive.
+type ArchiveReport struct {
+	Puzzles    []PuzzleDifficulty
+	Pangrams   []string
+	AveragePar float64
+}
+
+// BuildArchiveReport computes an ArchiveReport from BatchSolve results and
+// the dictionary they were solved against. Results are expected in
+// solve()'s usual order, shortest chain first, so a puzzle's first solution
+// is its par.
+func BuildArchiveReport(d *Dictionary, results []BatchResult) ArchiveReport {
+	var report ArchiveReport
+	seenPangrams := make(map[string]bool)
+	var totalPar, solvedCount int
+
+	for _, r := range results {
+		par := 0
+		if len(r.Solutions) > 0 {
+			par = len(r.Solutions[0])
+			totalPar += par
+			solvedCount++
+		}
+		report.Puzzles = append(report.Puzzles, PuzzleDifficulty{
+			Puzzle:        r.Puzzle,
+			Par:           par,
+			SolutionCount: len(r.Solutions),
+		})
+
+		for _, word := range allValidWords(d, r.Puzzle) {
+			if popcount(letterMask(word)) == len(r.Puzzle.Letters()) && !seenPangrams[word] {
+				seenPangrams[word] = true
+				report.Pangrams = append(report.Pangrams, word)
+			}
+		}
+	}
+
+	if solvedCount > 0 {
+		report.AveragePar = float64(totalPar) / float64(solvedCount)
+	}
+	sort.Strings(report.Pangrams)
+	return report
+}
+
+// CSV renders the report as CSV: one row per puzzle, followed by summary
+// rows for average par and the archive's pangrams.
+func (r ArchiveReport) CSV() string {
+	var b strings.Builder
+	b.WriteString("puzzle,par,solutions\n")
+	for _, p := range r.Puzzles {
+		fmt.Fprintf(&b, "%s,%d,%d\n", p.Puzzle, p.Par, p.SolutionCount)
+	}
+	fmt.Fprintf(&b, "\naverage par,%.2f\n", r.AveragePar)
+	fmt.Fprintf(&b, "pangrams,%s\n", strings.Join(r.Pangrams, " "))
+	return b.String()
+}
+
+// Markdown renders the report as a Markdown table suitable for pasting
+// straight into a blog post.
+func (r ArchiveReport) Markdown() string {
+	var b strings.Builder
+	b.WriteString("| puzzle | par | solutions |\n")
+	b.WriteString("|---|---|---|\n")
+	for _, p := range r.Puzzles {
+		fmt.Fprintf(&b, "| %s | %d | %d |\n", p.Puzzle, p.Par, p.SolutionCount)
+	}
+	fmt.Fprintf(&b, "\n**average par:** %.2f\n\n", r.AveragePar)
+	fmt.Fprintf(&b, "**pangrams:** %s\n", strings.Join(r.Pangrams, ", "))
+	return b.String()
+}
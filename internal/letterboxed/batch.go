@@ -0,0 +1,103 @@
+package letterboxed
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// BatchResult is one puzzle's outcome from BatchSolve.
+type BatchResult struct {
+	Puzzle    Puzzle
+	Solutions [][]string
+}
+
+// BatchSolve solves every puzzle in puzzles against d, using up to
+// concurrency worker goroutines. Results are returned in the same order as
+// puzzles, regardless of which worker finished first, so a caller can match
+// results back up with an archive of puzzles by index.
+func BatchSolve(d *Dictionary, puzzles []Puzzle, maxWords, concurrency int) []BatchResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	results := make([]BatchResult, len(puzzles))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = BatchResult{
+					Puzzle:    puzzles[i],
+					Solutions: solve(d, puzzles[i], maxWords),
+				}
+			}
+		}()
+	}
+
+	for i := range puzzles {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// BatchSolveStream behaves like BatchSolve but calls emit with each result
+// as soon as it's ready, in completion order rather than input order, so a
+// caller streaming results (e.g. over HTTP as NDJSON) can start writing
+// before the slowest puzzle in the batch finishes.
+func BatchSolveStream(d *Dictionary, puzzles []Puzzle, maxWords, concurrency int, emit func(BatchResult)) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	jobs := make(chan Puzzle)
+	results := make(chan BatchResult)
+
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range jobs {
+				results <- BatchResult{Puzzle: p, Solutions: solve(d, p, maxWords)}
+			}
+		}()
+	}
+	go func() {
+		for _, p := range puzzles {
+			jobs <- p
+		}
+		close(jobs)
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	for r := range results {
+		emit(r)
+	}
+}
+
+// ParseBatchSpec decodes a JSON array of {"sides": [...]} puzzle specs, the
+// same shape FetchPuzzleFromURL expects from a single puzzle, into a slice
+// of Puzzle, so an archive-wide batch solve can be fed from a file without
+// callers hand-rolling the JSON shape.
+func ParseBatchSpec(data []byte) ([]Puzzle, error) {
+	var specs []puzzleSpec
+	if err := json.Unmarshal(data, &specs); err != nil {
+		return nil, &InputError{Reason: "parse batch spec: invalid JSON: " + err.Error()}
+	}
+	puzzles := make([]Puzzle, 0, len(specs))
+	for _, spec := range specs {
+		sides, err := ParseSides(strings.Join(spec.Sides, ","))
+		if err != nil {
+			return nil, err
+		}
+		puzzles = append(puzzles, NewPuzzle(sides))
+	}
+	return puzzles, nil
+}
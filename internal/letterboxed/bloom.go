@@ -0,0 +1,69 @@
+package letterboxed
+
+// bloomFilter is a fixed-size Bloom filter over dictionary words, built once
+// at load time and checked before the trie on every hot validity path. A
+// negative answer from the filter is certain, so it lets bulk membership
+// checks (Wordle-style filtering, grid search over a puzzle's candidate
+// words) skip the trie walk entirely for the common case of a word that
+// isn't in the dictionary; a positive answer still needs the trie to rule
+// out false positives.
+type bloomFilter struct {
+	bits []uint64
+	k    int
+}
+
+// newBloomFilter sizes a filter for n expected words at roughly a 1% false
+// positive rate, using the standard m = -n*ln(p)/(ln 2)^2 and
+// k = (m/n)*ln 2 formulas, rounded to convenient fixed constants since the
+// dictionary size is known up front and doesn't change often enough to
+// warrant dynamic resizing.
+func newBloomFilter(n int) *bloomFilter {
+	if n < 1 {
+		n = 1
+	}
+	bits := n * 10 // ~10 bits per word for a ~1% false positive rate
+	words := (bits + 63) / 64
+	if words < 1 {
+		words = 1
+	}
+	return &bloomFilter{bits: make([]uint64, words), k: 7}
+}
+
+// fnv1aHash hashes word with seed mixed in, giving bloomFilter k
+// independent-enough hash values from a single cheap hash function instead
+// of k separate hash implementations.
+func fnv1aHash(word string, seed uint32) uint64 {
+	h := uint64(14695981039346656037) ^ uint64(seed)
+	for i := 0; i < len(word); i++ {
+		h ^= uint64(word[i])
+		h *= 1099511628211
+	}
+	return h
+}
+
+func (b *bloomFilter) indices(word string) []uint64 {
+	idx := make([]uint64, b.k)
+	nbits := uint64(len(b.bits) * 64)
+	for i := 0; i < b.k; i++ {
+		idx[i] = fnv1aHash(word, uint32(i)) % nbits
+	}
+	return idx
+}
+
+// add records word in the filter.
+func (b *bloomFilter) add(word string) {
+	for _, i := range b.indices(word) {
+		b.bits[i/64] |= 1 << (i % 64)
+	}
+}
+
+// mightContain reports whether word could be in the set the filter was
+// built from. false is certain; true may be a false positive.
+func (b *bloomFilter) mightContain(word string) bool {
+	for _, i := range b.indices(word) {
+		if b.bits[i/64]&(1<<(i%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
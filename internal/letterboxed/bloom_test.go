@@ -0,0 +1,36 @@
+package letterboxed
+
+import "testing"
+
+func TestBloomFilterNoFalseNegatives(t *testing.T) {
+	words := []string{"cat", "dog", "bird", "catalog", "zebra"}
+	b := newBloomFilter(len(words))
+	for _, w := range words {
+		b.add(w)
+	}
+	for _, w := range words {
+		if !b.mightContain(w) {
+			t.Errorf("mightContain(%q) = false after add, want true", w)
+		}
+	}
+}
+
+func TestBloomFilterRejectsObviouslyAbsentWords(t *testing.T) {
+	b := newBloomFilter(8)
+	b.add("cat")
+	b.add("dog")
+
+	if b.mightContain("xyzzyplugh") {
+		t.Error("mightContain(\"xyzzyplugh\") = true, want false for a word never added to a small filter")
+	}
+}
+
+func TestDictionaryHasUsesBloomFilter(t *testing.T) {
+	d := NewDictionary("cat\ndog\nbird\n")
+	if !d.has("cat") {
+		t.Error("has(\"cat\") = false, want true")
+	}
+	if d.has("elephant") {
+		t.Error("has(\"elephant\") = true, want false")
+	}
+}
@@ -0,0 +1,18 @@
+package letterboxed
+
+// SolveBounded behaves like Solve, but stops searching once maxResults
+// solutions have been found instead of exhausting the whole search space.
+// It's meant for constrained environments (low-memory containers, CI) where
+// a puzzle with a huge solution count could otherwise build an
+// unreasonably large result slice.
+func SolveBounded(d *Dictionary, p Puzzle, maxWords, maxResults int) [][]string {
+	var results [][]string
+	for chain := range SolveSeq(d, p, maxWords) {
+		results = append(results, chain)
+		if len(results) >= maxResults {
+			break
+		}
+	}
+	sortChains(results)
+	return results
+}
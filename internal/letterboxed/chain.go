@@ -0,0 +1,161 @@
+package letterboxed
+
+import "sort"
+
+// maskBucket groups a set of words by their own letter-coverage mask, a
+// second index level nested inside chainIndex's per-starting-letter
+// buckets. It answers "does this bucket contain a word covering at least
+// these letters" by enumerating target's supersets within the bucket's own
+// letters, rather than scanning every word the bucket holds.
+type maskBucket struct {
+	byMask map[uint32][]string
+	union  uint32
+}
+
+func newMaskBucket(words []wordMask) *maskBucket {
+	b := &maskBucket{byMask: make(map[uint32][]string)}
+	for _, wm := range words {
+		b.byMask[wm.mask] = append(b.byMask[wm.mask], wm.word)
+		b.union |= wm.mask
+	}
+	return b
+}
+
+// coveringAtLeast returns every word in the bucket whose mask is a
+// superset of target, walking target's supersets within the bucket's union
+// of letters via the standard submask-of-complement trick: enumerating the
+// submasks of (union &^ target) and OR-ing each back into target visits
+// exactly the masks that are supersets of target and no others.
+func (b *maskBucket) coveringAtLeast(target uint32) []string {
+	var out []string
+	rest := b.union &^ target
+	sub := rest
+	for {
+		out = append(out, b.byMask[target|sub]...)
+		if sub == 0 {
+			break
+		}
+		sub = (sub - 1) & rest
+	}
+	return out
+}
+
+// wordMask pairs a playable word with its precomputed letter-coverage mask,
+// so joining two words only needs a bitwise OR instead of rescanning both
+// strings.
+type wordMask struct {
+	word string
+	mask uint32
+}
+
+// chainIndex is a two-level index over playable words: the first level
+// buckets by starting letter, for the common case of chaining ("what words
+// start with the letter this one ended on?"); the second level, within
+// each starting-letter bucket, buckets further by coverage mask, for
+// "which of those words cover at least these remaining letters?" queries
+// used to join a two-word solution or to find words that finish a chain.
+type chainIndex map[byte]*maskBucket
+
+// buildChainIndex indexes all by starting letter, then by coverage mask
+// within each starting letter. It's built once per puzzle and reused
+// across every pair considered, rather than re-walking the trie or
+// rescanning the whole word list per candidate.
+func buildChainIndex(all []string) chainIndex {
+	byLetter := make(map[byte][]wordMask, 26)
+	for _, w := range all {
+		byLetter[w[0]] = append(byLetter[w[0]], wordMask{word: w, mask: letterMask(w)})
+	}
+	idx := make(chainIndex, len(byLetter))
+	for letter, words := range byLetter {
+		idx[letter] = newMaskBucket(words)
+	}
+	return idx
+}
+
+// wordsStartingWithCovering returns the indexed words that start with
+// letter and cover at least the letters in target, using the two-level
+// index instead of scanning every word starting with letter.
+func (idx chainIndex) wordsStartingWithCovering(letter byte, target uint32) []string {
+	bucket, ok := idx[letter]
+	if !ok {
+		return nil
+	}
+	return bucket.coveringAtLeast(target)
+}
+
+// BridgeLetterCounts reports, for each letter that appears as the bridge of
+// at least one two-word solution (the last letter of the first word, which
+// is also the first letter of the second), how many two-word solutions
+// pivot on it. It's meant to help a player intuit where to look next:
+// a letter with many pivots has many viable second words to try.
+func BridgeLetterCounts(d *Dictionary, p Puzzle) map[byte]int {
+	all := AllValidWords(d, p)
+	target := fullMask(p)
+	idx := buildChainIndex(all)
+	counts := make(map[byte]int)
+	for _, w1 := range all {
+		bridge := w1[len(w1)-1]
+		needed := target &^ letterMask(w1)
+		n := len(idx.wordsStartingWithCovering(bridge, needed))
+		if n > 0 {
+			counts[bridge] += n
+		}
+	}
+	return counts
+}
+
+// FindPartners returns every word that completes a two-word solution with
+// word, whether word comes first or second in the pair, sorted with common
+// words before obscure ones so the most findable suggestions surface first.
+// It's the reverse of solving from scratch: given a word a player already
+// likes, what else finishes the puzzle alongside it?
+func FindPartners(d *Dictionary, p Puzzle, word string) []string {
+	all := AllValidWords(d, p)
+	target := fullMask(p)
+	idx := buildChainIndex(all)
+
+	seen := make(map[string]bool)
+	var partners []string
+	add := func(w string) {
+		if w != word && !seen[w] {
+			seen[w] = true
+			partners = append(partners, w)
+		}
+	}
+
+	needed := target &^ letterMask(word)
+	for _, w2 := range idx.wordsStartingWithCovering(word[len(word)-1], needed) {
+		add(w2)
+	}
+	for _, w1 := range all {
+		if w1[len(w1)-1] != word[0] {
+			continue
+		}
+		if target&^(letterMask(w1)|letterMask(word)) == 0 {
+			add(w1)
+		}
+	}
+
+	sort.SliceStable(partners, func(i, j int) bool {
+		return !IsObscure(partners[i]) && IsObscure(partners[j])
+	})
+	return partners
+}
+
+// twoWordChains finds every pair of words (w1, w2) where w2 starts with the
+// last letter of w1 and the two together cover target. Since every
+// playable word's letters are already a subset of target, w1 and w2
+// together cover target exactly when w2 covers the letters w1 left
+// uncovered, so this reduces to one wordsStartingWithCovering lookup per
+// first word instead of rescanning its whole starting-letter bucket.
+func twoWordChains(all []string, target uint32) [][]string {
+	idx := buildChainIndex(all)
+	var results [][]string
+	for _, w1 := range all {
+		needed := target &^ letterMask(w1)
+		for _, w2 := range idx.wordsStartingWithCovering(w1[len(w1)-1], needed) {
+			results = append(results, []string{w1, w2})
+		}
+	}
+	return results
+}
@@ -0,0 +1,72 @@
+package letterboxed
+
+import "testing"
+
+// twoWordPuzzle returns a puzzle and dictionary with exactly one two-word
+// solution ("cat" -> "toxybigdne"), so tests can assert on bridge letters
+// and partner lookups without depending on the real dictionary's shape.
+func twoWordPuzzle() (*Dictionary, Puzzle) {
+	p := NewPuzzle([4]string{"cxg", "ayd", "tbn", "oie"})
+	d := NewDictionary("cat\ntoxybigdne\n")
+	return d, p
+}
+
+func TestBridgeLetterCountsSumsTwoWordPairs(t *testing.T) {
+	d, p := twoWordPuzzle()
+
+	counts := BridgeLetterCounts(d, p)
+
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	chains := twoWordChains(AllValidWords(d, p), fullMask(p))
+	if total != len(chains) {
+		t.Errorf("BridgeLetterCounts totals %d, want %d (len(twoWordChains))", total, len(chains))
+	}
+	if counts['t'] == 0 {
+		t.Errorf("counts['t'] = 0, want > 0 since cat->toxybigdne bridges on 't'")
+	}
+}
+
+func TestBridgeLetterCountsEmptyWhenNoChains(t *testing.T) {
+	d := NewDictionary("cat\n")
+	p := NewPuzzle([4]string{"cxg", "ayd", "tbn", "oie"})
+
+	counts := BridgeLetterCounts(d, p)
+	if len(counts) != 0 {
+		t.Errorf("BridgeLetterCounts = %v, want empty (no word covers the puzzle)", counts)
+	}
+}
+
+func TestFindPartnersBothOrders(t *testing.T) {
+	d, p := twoWordPuzzle()
+
+	partners := FindPartners(d, p, "cat")
+	found := make(map[string]bool)
+	for _, w := range partners {
+		found[w] = true
+	}
+	if !found["toxybigdne"] {
+		t.Errorf("FindPartners(cat) = %v, want to include toxybigdne", partners)
+	}
+	for _, w := range partners {
+		if w == "cat" {
+			t.Errorf("FindPartners(cat) = %v, should not include the query word itself", partners)
+		}
+	}
+
+	reverse := FindPartners(d, p, "toxybigdne")
+	if !contains(reverse, "cat") {
+		t.Errorf("FindPartners(toxybigdne) = %v, want to include cat", reverse)
+	}
+}
+
+func contains(words []string, target string) bool {
+	for _, w := range words {
+		if w == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package letterboxed
+
+// CompletionCandidates returns every playable word that would finish the
+// puzzle if appended to chainSoFar: it starts with chainSoFar's last
+// letter (or any starting letter, if chainSoFar is empty) and covers every
+// letter chainSoFar hasn't covered yet. Built on the two-level chainIndex,
+// it answers the common "is there a single word that finishes this right
+// now?" query without Hint's full A* search.
+func CompletionCandidates(d *Dictionary, p Puzzle, chainSoFar []string) []string {
+	all := allValidWords(d, p)
+	target := fullMask(p)
+
+	var covered uint32
+	var lastLetter byte
+	for _, w := range chainSoFar {
+		covered |= letterMask(w)
+		lastLetter = w[len(w)-1]
+	}
+	needed := target &^ covered
+
+	idx := buildChainIndex(all)
+	if lastLetter == 0 {
+		var out []string
+		for letter := byte('a'); letter <= 'z'; letter++ {
+			out = append(out, idx.wordsStartingWithCovering(letter, needed)...)
+		}
+		return out
+	}
+	return idx.wordsStartingWithCovering(lastLetter, needed)
+}
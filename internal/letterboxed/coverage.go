@@ -0,0 +1,28 @@
+package letterboxed
+
+// CoverageStep reports one word's contribution to a chain's letter
+// coverage: how many of the puzzle's twelve letters the word itself uses,
+// and the running total covered by the chain once that word is added.
+type CoverageStep struct {
+	Word       string
+	OwnLetters int
+	Cumulative int
+}
+
+// ChainCoverage walks chain word by word, reporting each word's own
+// distinct-letter count and the chain's cumulative coverage so far, so a
+// solution can be rendered as a per-word progress bar instead of just
+// read as plain text.
+func ChainCoverage(p Puzzle, chain []string) []CoverageStep {
+	steps := make([]CoverageStep, len(chain))
+	var covered uint32
+	for i, word := range chain {
+		covered |= letterMask(word)
+		steps[i] = CoverageStep{
+			Word:       word,
+			OwnLetters: popcount(letterMask(word)),
+			Cumulative: popcount(covered),
+		}
+	}
+	return steps
+}
@@ -0,0 +1,32 @@
+package letterboxed
+
+// CrossCheckResult reports whether three independent ways of answering
+// "can this puzzle be solved" agree with each other.
+type CrossCheckResult struct {
+	Agrees         bool
+	SolveCount     int
+	CountResult    int
+	IDDFSFoundSome bool
+}
+
+// CrossCheckSolve re-derives Solve's answer two independent ways and
+// reports any disagreement: a bug in one solving strategy should surface
+// as a mismatch here rather than silently shipping wrong answers.
+//
+// It compares Solve's chain count against NumberOfSolutions, which counts
+// without materializing chains, and against SolveIterativeDeepening, which
+// explores the same search space in a different order.
+func CrossCheckSolve(d *Dictionary, p Puzzle, maxWords int) CrossCheckResult {
+	solveChains := Solve(d, p, maxWords)
+	count := NumberOfSolutions(d, p, maxWords)
+	iddfsChains := SolveIterativeDeepening(d, p, maxWords)
+
+	result := CrossCheckResult{
+		SolveCount:     len(solveChains),
+		CountResult:    count,
+		IDDFSFoundSome: len(iddfsChains) > 0,
+	}
+	result.Agrees = result.SolveCount == result.CountResult &&
+		result.IDDFSFoundSome == (result.SolveCount > 0)
+	return result
+}
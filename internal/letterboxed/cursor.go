@@ -0,0 +1,84 @@
+package letterboxed
+
+// TrieCursor walks the dictionary trie one letter at a time against a
+// puzzle's adjacency rules, giving a caller (e.g. a keystroke-driven input
+// widget) live feedback on a prospective word as it's typed, without
+// re-validating the whole prefix from scratch on every keystroke.
+type TrieCursor struct {
+	d        *Dictionary
+	p        Puzzle
+	node     *trieNode
+	lastSide int
+	prefix   []byte
+	viable   bool
+}
+
+// NewTrieCursor starts a cursor at the root of d's trie, ready to advance
+// letter by letter.
+func NewTrieCursor(d *Dictionary, p Puzzle) *TrieCursor {
+	return &TrieCursor{d: d, p: p, node: d.trie.root(), lastSide: -1, viable: true}
+}
+
+// Advance extends the cursor's prefix by one letter, applying the same
+// side-adjacency rule the solver enforces. It reports whether the prefix
+// is still viable (could still become a playable word); once a cursor
+// stops being viable it stays that way until Reset.
+func (c *TrieCursor) Advance(letter byte) bool {
+	if !c.viable {
+		return false
+	}
+	side := c.p.sideOf(letter)
+	if side == -1 || side == c.lastSide {
+		c.viable = false
+		return false
+	}
+	child := c.d.trie.child(c.node, letter)
+	if child == nil {
+		c.viable = false
+		return false
+	}
+	c.node = child
+	c.lastSide = side
+	c.prefix = append(c.prefix, letter)
+	return true
+}
+
+// Reset returns the cursor to the empty prefix, ready to type a new word.
+func (c *TrieCursor) Reset() {
+	c.node = c.d.trie.root()
+	c.lastSide = -1
+	c.prefix = c.prefix[:0]
+	c.viable = true
+}
+
+// Viable reports whether the current prefix could still complete into a
+// playable word.
+func (c *TrieCursor) Viable() bool {
+	return c.viable
+}
+
+// IsWord reports whether the current prefix is itself a complete,
+// minimum-length playable word.
+func (c *TrieCursor) IsWord() bool {
+	return c.viable && len(c.prefix) >= 3 && c.node.isWord
+}
+
+// Completions returns up to n dictionary words that complete the current
+// prefix and are playable on the puzzle from here on, for a "best
+// completions" display. They're returned in the trie's natural order, not
+// sorted or ranked, since a live typing widget only needs a handful of
+// suggestions rather than an exhaustive, ordered list.
+func (c *TrieCursor) Completions(n int) []string {
+	if !c.viable {
+		return nil
+	}
+	var out []string
+	buf := getBuf()
+	defer putBuf(buf)
+	copy(buf, c.prefix)
+	validWordHelper(c.d.trie, c.node, c.p, buf, len(c.prefix), c.lastSide, func(word string) bool {
+		out = append(out, word)
+		return len(out) < n
+	})
+	return out
+}
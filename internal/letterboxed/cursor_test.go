@@ -0,0 +1,50 @@
+package letterboxed
+
+import "testing"
+
+func TestTrieCursorAdvance(t *testing.T) {
+	d := NewDictionary("cat\ncats\ndog\n")
+	p := NewPuzzle([4]string{"aso", "ctlgd", "xyz", "qvw"})
+
+	c := NewTrieCursor(d, p)
+	if !c.Advance('c') || !c.Advance('a') {
+		t.Fatal("Advance failed on a valid prefix")
+	}
+	if c.IsWord() {
+		t.Error("IsWord() = true after \"ca\", want false")
+	}
+	if !c.Advance('t') {
+		t.Fatal("Advance failed extending \"ca\" to \"cat\"")
+	}
+	if !c.IsWord() {
+		t.Error("IsWord() = false after \"cat\", want true")
+	}
+}
+
+func TestTrieCursorDeadEndOnSameSideLetters(t *testing.T) {
+	p := NewPuzzle([4]string{"cat", "dog", "xyz", "qvw"})
+	d := NewDictionary("cca\n")
+
+	c := NewTrieCursor(d, p)
+	c.Advance('c')
+	if c.Advance('c') {
+		t.Error("Advance(repeat side letter) = true, want false")
+	}
+	if c.Viable() {
+		t.Error("Viable() = true after a same-side adjacency violation, want false")
+	}
+}
+
+func TestTrieCursorCompletions(t *testing.T) {
+	d := NewDictionary("cat\ncats\ncatalog\ndog\n")
+	p := NewPuzzle([4]string{"aso", "ctlgd", "xyz", "qvw"})
+
+	c := NewTrieCursor(d, p)
+	c.Advance('c')
+	c.Advance('a')
+	c.Advance('t')
+	completions := c.Completions(10)
+	if len(completions) == 0 {
+		t.Fatal("Completions(10) = empty, want at least \"cat\" itself")
+	}
+}
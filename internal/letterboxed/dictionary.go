@@ -0,0 +1,106 @@
+package letterboxed
+
+import (
+	_ "embed"
+	"strings"
+)
+
+//go:embed assets/words.txt
+var defaultWordList string
+
+// Dictionary is a loaded word list indexed for puzzle solving.
+type Dictionary struct {
+	trie  *Trie
+	words []string
+	bloom *bloomFilter
+}
+
+// NewDictionary builds a Dictionary from a newline-separated word list.
+// Words shorter than three letters are skipped, since Letter Boxed never
+// accepts them.
+func NewDictionary(wordList string) *Dictionary {
+	d := &Dictionary{trie: NewTrie()}
+	for _, w := range strings.Split(wordList, "\n") {
+		w = strings.ToLower(strings.TrimSpace(w))
+		if len(w) < 3 || !isAlpha(w) {
+			continue
+		}
+		d.trie.Insert(w)
+		d.words = append(d.words, w)
+	}
+	d.rebuildBloom()
+	return d
+}
+
+// rebuildBloom rebuilds the Bloom filter prefilter from the current word
+// list, sized for its current length. AddWord sets the new word's bits in
+// place instead of calling it, and RemoveWord leaves the filter as it is,
+// since Bloom filters don't support removal.
+func (d *Dictionary) rebuildBloom() {
+	d.bloom = newBloomFilter(len(d.words))
+	for _, w := range d.words {
+		d.bloom.add(w)
+	}
+}
+
+// has reports whether word is in the dictionary, checking the Bloom filter
+// first so the common case of a word that isn't in the dictionary at all
+// (the bulk of candidates during grid search or Wordle-style filtering)
+// avoids walking the trie.
+func (d *Dictionary) has(word string) bool {
+	if !d.bloom.mightContain(word) {
+		return false
+	}
+	return d.trie.Has(word)
+}
+
+// DefaultDictionary loads the word list embedded into the binary.
+func DefaultDictionary() *Dictionary {
+	return NewDictionary(defaultWordList)
+}
+
+// Len returns the number of words in the dictionary.
+func (d *Dictionary) Len() int {
+	return len(d.words)
+}
+
+// Clone returns an independent copy of d: mutating the clone with AddWord
+// or RemoveWord never affects d or anything still reading it. This is the
+// building block for a copy-on-write update — build a Clone, edit it, and
+// swap it in — instead of mutating a Dictionary that concurrent readers
+// (like an in-flight solve walking the trie) might still be using.
+func (d *Dictionary) Clone() *Dictionary {
+	words := make([]string, len(d.words))
+	copy(words, d.words)
+	return NewDictionary(strings.Join(words, "\n"))
+}
+
+// AddWord inserts word into the dictionary in place. Because solving reads
+// straight from the trie, a solve started after AddWord returns sees the
+// new word immediately — there's no separate index to rebuild.
+func (d *Dictionary) AddWord(word string) {
+	word = strings.ToLower(strings.TrimSpace(word))
+	if len(word) < 3 || !isAlpha(word) || d.trie.Has(word) {
+		return
+	}
+	d.trie.Insert(word)
+	d.words = append(d.words, word)
+	d.bloom.add(word)
+}
+
+// RemoveWord drops word from the dictionary in place, the mirror of
+// AddWord.
+func (d *Dictionary) RemoveWord(word string) {
+	word = strings.ToLower(strings.TrimSpace(word))
+	d.trie.Remove(word)
+	for i, w := range d.words {
+		if w == word {
+			d.words = append(d.words[:i], d.words[i+1:]...)
+			break
+		}
+	}
+	// The Bloom filter has no removal operation, so a removed word still
+	// might-contain until the next full rebuild; the trie lookup it guards
+	// stays authoritative, so this only costs an occasional wasted trie
+	// check rather than an incorrect answer.
+}
@@ -0,0 +1,44 @@
+package letterboxed
+
+import "fmt"
+
+// InputError reports malformed puzzle input (bad sides, bad word), as
+// opposed to an I/O or dictionary-backend failure. Callers can use
+// errors.As to distinguish "the user typed something invalid" from
+// "something went wrong loading data" and react differently (e.g. a CLI
+// re-prompting vs. an HTTP 400 vs. a 500).
+type InputError struct {
+	Reason string
+}
+
+func (e *InputError) Error() string {
+	return fmt.Sprintf("letterboxed: %s", e.Reason)
+}
+
+// UnsolvableError reports that a puzzle can't be solved against a given
+// dictionary because one of its letters appears in no playable word.
+type UnsolvableError struct {
+	Puzzle Puzzle
+	Letter byte
+}
+
+func (e *UnsolvableError) Error() string {
+	return fmt.Sprintf("letterboxed: letter %q appears in no playable word; puzzle %s is unsolvable with this dictionary",
+		string(e.Letter), e.Puzzle)
+}
+
+// DictionaryError wraps a failure loading or querying a dictionary backend
+// (e.g. SQLite), recording which operation failed alongside the
+// underlying error.
+type DictionaryError struct {
+	Op  string
+	Err error
+}
+
+func (e *DictionaryError) Error() string {
+	return fmt.Sprintf("letterboxed: %s: %v", e.Op, e.Err)
+}
+
+func (e *DictionaryError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,35 @@
+package letterboxed
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExplainInvalid diagnoses why word isn't playable on p, returning "" if it
+// actually is valid. It checks the same conditions as IsValidWord, but
+// stops at the first one it finds and describes it, rather than just
+// reporting a flat true/false.
+func ExplainInvalid(d *Dictionary, p Puzzle, word string) string {
+	word = strings.ToLower(strings.TrimSpace(word))
+	if len(word) < 3 {
+		return "too short: words must be at least 3 letters"
+	}
+	if !isAlpha(word) {
+		return "contains a non-letter character"
+	}
+	lastSide := -1
+	for i := 0; i < len(word); i++ {
+		side := p.sideOf(word[i])
+		if side == -1 {
+			return fmt.Sprintf("letter %q is not on the puzzle", string(word[i]))
+		}
+		if side == lastSide {
+			return fmt.Sprintf("%q and %q are on the same side and can't be adjacent", string(word[i-1]), string(word[i]))
+		}
+		lastSide = side
+	}
+	if !d.trie.Has(word) {
+		return fmt.Sprintf("%q is not in the dictionary", word)
+	}
+	return ""
+}
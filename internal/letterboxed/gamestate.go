@@ -0,0 +1,60 @@
+package letterboxed
+
+// GameState is an immutable snapshot of progress through a puzzle: the
+// words played so far and the letters they cover. Every method that
+// advances play returns a new GameState rather than modifying the
+// receiver, so a caller can hold onto an earlier snapshot for undo, branch
+// into multiple continuations from the same point, or hand a snapshot to a
+// background goroutine (e.g. computing a hint) without racing further
+// play.
+type GameState struct {
+	words   []string
+	covered uint32
+}
+
+// NewGameState returns the starting state, before any word has been
+// played.
+func NewGameState() GameState {
+	return GameState{}
+}
+
+// Play returns a new GameState with word appended, leaving the receiver
+// unchanged. The returned state's word list never aliases the receiver's
+// backing array, so branching into two different continuations from the
+// same GameState is always safe.
+func (s GameState) Play(word string) GameState {
+	words := make([]string, len(s.words)+1)
+	copy(words, s.words)
+	words[len(words)-1] = word
+	return GameState{words: words, covered: s.covered | letterMask(word)}
+}
+
+// Words returns the words played so far, in order. The returned slice is
+// owned by the caller; mutating it never affects the GameState it came
+// from.
+func (s GameState) Words() []string {
+	words := make([]string, len(s.words))
+	copy(words, s.words)
+	return words
+}
+
+// Covered returns the bitmask of letters covered by the words played so
+// far.
+func (s GameState) Covered() uint32 {
+	return s.covered
+}
+
+// LastLetter returns the last letter of the most recently played word, or
+// 0 if nothing has been played yet.
+func (s GameState) LastLetter() byte {
+	if len(s.words) == 0 {
+		return 0
+	}
+	last := s.words[len(s.words)-1]
+	return last[len(last)-1]
+}
+
+// Solved reports whether every letter on p has been covered.
+func (s GameState) Solved(p Puzzle) bool {
+	return s.covered == fullMask(p)
+}
@@ -0,0 +1,42 @@
+package letterboxed
+
+import "testing"
+
+func TestGameStatePlayDoesNotMutateReceiver(t *testing.T) {
+	start := NewGameState()
+	after := start.Play("cat")
+
+	if len(start.Words()) != 0 {
+		t.Errorf("Play mutated the receiver: start.Words() = %v, want empty", start.Words())
+	}
+	if got := after.Words(); len(got) != 1 || got[0] != "cat" {
+		t.Errorf("after.Words() = %v, want [cat]", got)
+	}
+}
+
+func TestGameStateBranchesDontAlias(t *testing.T) {
+	base := NewGameState().Play("cat")
+	branchA := base.Play("taxi")
+	branchB := base.Play("table")
+
+	if got := branchA.Words(); len(got) != 2 || got[1] != "taxi" {
+		t.Errorf("branchA.Words() = %v, want [cat taxi]", got)
+	}
+	if got := branchB.Words(); len(got) != 2 || got[1] != "table" {
+		t.Errorf("branchB.Words() = %v, want [cat table]", got)
+	}
+	if got := base.Words(); len(got) != 1 {
+		t.Errorf("base.Words() = %v, want [cat] (branching should not affect base)", got)
+	}
+}
+
+func TestGameStateSolved(t *testing.T) {
+	p := NewPuzzle([4]string{"abc", "def", "ghi", "jkl"})
+	state := NewGameState().Play("abcdefghijkl")
+	if !state.Solved(p) {
+		t.Error("Solved() = false after playing a word covering every letter, want true")
+	}
+	if NewGameState().Solved(p) {
+		t.Error("Solved() = true for the empty state, want false")
+	}
+}
@@ -0,0 +1,67 @@
+package letterboxed
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+// maxGenerateAttempts bounds how many random layouts GenerateDaily tries
+// before giving up on finding a solvable one.
+const maxGenerateAttempts = 200
+
+// GenerateDaily deterministically builds a puzzle for date: the same date
+// and dictionary always produce the same puzzle, the way the real NYT
+// puzzle of the day is fixed in advance rather than randomized per player.
+// It draws twelve distinct letters from the words in d and arranges them
+// into four sides, retrying until the result passes CheckSolvable.
+func GenerateDaily(d *Dictionary, date time.Time) (Puzzle, error) {
+	day := date.UTC().Truncate(24 * time.Hour).Unix()
+	return GenerateWithSeed(d, strconv.FormatInt(day, 10))
+}
+
+// GenerateWithSeed deterministically builds a puzzle from an arbitrary seed
+// key: the same key and dictionary always produce the same puzzle. This is
+// what GenerateDaily is built on, but any reproducible label works, e.g. a
+// practice puzzle number or a shared seed for a multiplayer challenge.
+func GenerateWithSeed(d *Dictionary, key string) (Puzzle, error) {
+	rng := seededRand(key)
+
+	var lastErr error
+	for attempt := 0; attempt < maxGenerateAttempts; attempt++ {
+		letters := drawLetters(rng, d.words)
+		if letters == "" {
+			continue
+		}
+		p := NewPuzzle([4]string{letters[0:3], letters[3:6], letters[6:9], letters[9:12]})
+		if err := CheckSolvable(d, p); err != nil {
+			lastErr = err
+			continue
+		}
+		return p, nil
+	}
+	return Puzzle{}, lastErr
+}
+
+// drawLetters picks twelve distinct letters by sampling real dictionary
+// words, so the resulting set reflects actual English letter co-occurrence
+// rather than a uniform draw over the alphabet.
+func drawLetters(rng *rand.Rand, words []string) string {
+	seen := [26]bool{}
+	var letters []byte
+	for len(letters) < 12 && len(words) > 0 {
+		word := words[rng.Intn(len(words))]
+		for i := 0; i < len(word) && len(letters) < 12; i++ {
+			idx := word[i] - 'a'
+			if !seen[idx] {
+				seen[idx] = true
+				letters = append(letters, word[i])
+			}
+		}
+	}
+	if len(letters) < 12 {
+		return ""
+	}
+	rng.Shuffle(len(letters), func(i, j int) { letters[i], letters[j] = letters[j], letters[i] })
+	return string(letters)
+}
@@ -0,0 +1,165 @@
+package letterboxed
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// astarState is one node in the hint search: the puzzle has covered letters
+// and the chain so far ends on lastLetter (0 for "no chain yet").
+type astarState struct {
+	covered    uint32
+	lastLetter byte
+}
+
+type astarItem struct {
+	state astarState
+	chain []string
+	g     int // words used so far
+	f     int // g + heuristic
+	index int
+}
+
+type astarQueue []*astarItem
+
+func (q astarQueue) Len() int           { return len(q) }
+func (q astarQueue) Less(i, j int) bool { return q[i].f < q[j].f }
+func (q astarQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i]; q[i].index, q[j].index = i, j }
+func (q *astarQueue) Push(x any)        { item := x.(*astarItem); item.index = len(*q); *q = append(*q, item) }
+func (q *astarQueue) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
+
+// maxCoverage is the largest number of distinct letters any single word in
+// words covers. It's used as the branching factor in the search heuristic.
+func maxCoverage(words []string) int {
+	best := 1
+	for _, w := range words {
+		if n := popcount(letterMask(w)); n > best {
+			best = n
+		}
+	}
+	return best
+}
+
+func popcount(mask uint32) int {
+	n := 0
+	for mask != 0 {
+		mask &= mask - 1
+		n++
+	}
+	return n
+}
+
+// heuristic lower-bounds the number of additional words needed to cover the
+// remaining letters: even a dictionary whose best word covers
+// maxLettersPerWord distinct letters can't finish any faster than that, so
+// this never overestimates the true remaining cost and the search stays
+// admissible.
+func heuristic(covered, target uint32, maxLettersPerWord int) int {
+	remaining := popcount(target &^ covered)
+	if remaining == 0 {
+		return 0
+	}
+	return (remaining + maxLettersPerWord - 1) / maxLettersPerWord
+}
+
+// HintLevel controls how much of the next word a hint gives away.
+type HintLevel int
+
+const (
+	// HintNudge reveals only which side of the puzzle the next word
+	// should start from.
+	HintNudge HintLevel = iota
+	// HintFirstLetter reveals the next word's starting letter.
+	HintFirstLetter
+	// HintReveal reveals the whole next word.
+	HintReveal
+)
+
+// HintAtLevel runs the same search as Hint, but only reveals as much of the
+// result as level asks for, so a player can ask for an increasingly
+// specific nudge instead of jumping straight to the answer.
+func HintAtLevel(d *Dictionary, p Puzzle, chainSoFar []string, level HintLevel) (string, bool) {
+	word, ok := Hint(d, p, chainSoFar)
+	if !ok {
+		return "", false
+	}
+	switch level {
+	case HintNudge:
+		side := p.sideOf(word[0])
+		return fmt.Sprintf("try a word starting from side %d (%s)", side+1, p.Sides[side]), true
+	case HintFirstLetter:
+		return fmt.Sprintf("try a word starting with %q", word[0]), true
+	default:
+		return word, true
+	}
+}
+
+// Hint runs an A* best-first search to extend chainSoFar with the fewest
+// additional words that complete the puzzle, and returns that next word. It
+// reports ok=false if no completion was found within the dictionary.
+func Hint(d *Dictionary, p Puzzle, chainSoFar []string) (next string, ok bool) {
+	target := fullMask(p)
+	all := allValidWords(d, p)
+	maxLettersPerWord := maxCoverage(all)
+
+	covered := uint32(0)
+	var lastLetter byte
+	for _, w := range chainSoFar {
+		covered |= letterMask(w)
+		lastLetter = w[len(w)-1]
+	}
+	if covered == target {
+		return "", false
+	}
+
+	start := &astarItem{
+		state: astarState{covered: covered, lastLetter: lastLetter},
+		g:     0,
+		f:     heuristic(covered, target, maxLettersPerWord),
+	}
+	pq := &astarQueue{start}
+	heap.Init(pq)
+	visited := map[astarState]bool{}
+
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(*astarItem)
+		if visited[item.state] {
+			continue
+		}
+		visited[item.state] = true
+		if item.state.covered == target {
+			if len(item.chain) == 0 {
+				return "", false
+			}
+			return item.chain[0], true
+		}
+
+		candidates := all
+		if item.g > 0 || len(chainSoFar) > 0 {
+			candidates = validWords(d, p, item.state.lastLetter)
+		}
+		for _, word := range candidates {
+			nextCovered := item.state.covered | letterMask(word)
+			nextState := astarState{covered: nextCovered, lastLetter: word[len(word)-1]}
+			if visited[nextState] {
+				continue
+			}
+			chain := make([]string, len(item.chain)+1)
+			copy(chain, item.chain)
+			chain[len(chain)-1] = word
+			heap.Push(pq, &astarItem{
+				state: nextState,
+				chain: chain,
+				g:     item.g + 1,
+				f:     item.g + 1 + heuristic(nextCovered, target, maxLettersPerWord),
+			})
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,43 @@
+package letterboxed
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// UnfinishedPuzzle pairs a puzzle with when it was started, so a reminder
+// can be scheduled to finish it later.
+type UnfinishedPuzzle struct {
+	Puzzle    Puzzle
+	StartedAt time.Time
+}
+
+// ExportICal renders a set of unfinished puzzles as an iCalendar (.ics)
+// document with one VTODO reminder per puzzle, due 24 hours after it was
+// started, so a calendar app can nudge the player to come back and finish.
+func ExportICal(puzzles []UnfinishedPuzzle) string {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//nyt-games//letterboxed//EN\r\n")
+
+	for i, up := range puzzles {
+		due := up.StartedAt.Add(24 * time.Hour)
+		b.WriteString("BEGIN:VTODO\r\n")
+		fmt.Fprintf(&b, "UID:letterboxed-%d-%d@nyt-games\r\n", up.StartedAt.Unix(), i)
+		fmt.Fprintf(&b, "DTSTAMP:%s\r\n", formatICalTime(up.StartedAt))
+		fmt.Fprintf(&b, "DUE:%s\r\n", formatICalTime(due))
+		fmt.Fprintf(&b, "SUMMARY:Finish Letter Boxed puzzle %s\r\n", up.Puzzle)
+		b.WriteString("END:VTODO\r\n")
+	}
+
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String()
+}
+
+// formatICalTime renders t in the UTC "basic" format iCalendar expects,
+// e.g. 20260305T090000Z.
+func formatICalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
@@ -0,0 +1,17 @@
+package letterboxed
+
+// SolveIterativeDeepening finds the shortest solution chains by trying
+// chain lengths 1, 2, 3, ... up to maxWords and stopping at the first
+// length that has any solutions. This avoids paying the cost of searching
+// at a longer depth whenever a shorter solution exists, which a single
+// fixed-depth Solve(d, p, maxWords) call can't avoid since it explores
+// every length up to maxWords regardless.
+func SolveIterativeDeepening(d *Dictionary, p Puzzle, maxWords int) [][]string {
+	maxWords = clampMaxWords(maxWords)
+	for depth := 1; depth <= maxWords; depth++ {
+		if results := solve(d, p, depth); len(results) > 0 {
+			return results
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package letterboxed
+
+import "iter"
+
+// AllValidWordsSeq is the iterator form of AllValidWords: it walks the
+// dictionary lazily, so a caller that only needs a count, a sample, or the
+// first few matches never pays for a fully materialized slice.
+func AllValidWordsSeq(d *Dictionary, p Puzzle) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		buf := getBuf()
+		defer putBuf(buf)
+		validWordHelper(d.trie, d.trie.root(), p, buf, 0, -1, yield)
+	}
+}
+
+// SolveSeq streams solution chains as they're found rather than searching
+// the whole tree up front, so a caller like the REPL can print the first
+// solutions within milliseconds and stop early instead of waiting for an
+// exhaustive search to finish.
+func SolveSeq(d *Dictionary, p Puzzle, maxWords int) iter.Seq[[]string] {
+	maxWords = clampMaxWords(maxWords)
+	target := fullMask(p)
+	all := allValidWords(d, p)
+	if maxWords == 2 {
+		return func(yield func([]string) bool) {
+			for _, pair := range twoWordChains(all, target) {
+				if !yield(pair) {
+					return
+				}
+			}
+		}
+	}
+	return func(yield func([]string) bool) {
+		chain := getChain()
+		defer putChain(chain)
+		solveHelper(all, target, chain, 0, 0, 0, maxWords, func(chain []string) bool {
+			solution := make([]string, len(chain))
+			copy(solution, chain)
+			return yield(solution)
+		})
+	}
+}
+
+// ValidWordsSeq is the iterator form of ValidWords: it lazily yields the
+// dictionary words playable on p that start with startLetter.
+func ValidWordsSeq(d *Dictionary, p Puzzle, startLetter byte) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		child := d.trie.child(d.trie.root(), startLetter)
+		if child == nil {
+			return
+		}
+		side := p.sideOf(startLetter)
+		if side == -1 {
+			return
+		}
+		buf := getBuf()
+		defer putBuf(buf)
+		buf[0] = startLetter
+		validWordHelper(d.trie, child, p, buf, 1, side, yield)
+	}
+}
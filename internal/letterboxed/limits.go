@@ -0,0 +1,36 @@
+package letterboxed
+
+import "fmt"
+
+// MaxWordsLimit caps how many words a chain search is allowed to look for.
+// Every recursive search helper (solveHelper, countingHelper, and
+// SolveWithRules' own walk) recurses to a depth of maxWords, so a caller
+// that passes an unreasonably large maxWords — say, straight from
+// unvalidated user input — could otherwise blow the goroutine stack before
+// ever finding a solution. No real Letter Boxed puzzle needs anywhere near
+// this many words to solve.
+const MaxWordsLimit = 16
+
+// ValidateMaxWords reports an error if maxWords is outside the range the
+// solver can safely recurse to, so a caller taking maxWords from a CLI
+// flag or HTTP query parameter can reject bad input with a clear message
+// before it ever reaches a recursive search.
+func ValidateMaxWords(maxWords int) error {
+	if maxWords < 1 || maxWords > MaxWordsLimit {
+		return &InputError{Reason: fmt.Sprintf("max words must be between 1 and %d, got %d", MaxWordsLimit, maxWords)}
+	}
+	return nil
+}
+
+// clampMaxWords bounds maxWords to a safe recursion depth as a last line
+// of defense for the search helpers themselves, independent of whether a
+// caller remembered to call ValidateMaxWords first.
+func clampMaxWords(maxWords int) int {
+	if maxWords < 1 {
+		return 1
+	}
+	if maxWords > MaxWordsLimit {
+		return MaxWordsLimit
+	}
+	return maxWords
+}
@@ -0,0 +1,39 @@
+package letterboxed
+
+import "testing"
+
+func TestValidateMaxWords(t *testing.T) {
+	cases := []struct {
+		maxWords int
+		wantErr  bool
+	}{
+		{0, true},
+		{-1, true},
+		{1, false},
+		{MaxWordsLimit, false},
+		{MaxWordsLimit + 1, true},
+	}
+	for _, tc := range cases {
+		err := ValidateMaxWords(tc.maxWords)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("ValidateMaxWords(%d) error = %v, wantErr %v", tc.maxWords, err, tc.wantErr)
+		}
+	}
+}
+
+func TestClampMaxWordsProtectsAdversarialInput(t *testing.T) {
+	if got := clampMaxWords(1_000_000); got != MaxWordsLimit {
+		t.Errorf("clampMaxWords(1_000_000) = %d, want %d", got, MaxWordsLimit)
+	}
+	if got := clampMaxWords(0); got != 1 {
+		t.Errorf("clampMaxWords(0) = %d, want 1", got)
+	}
+}
+
+func TestSolveWithAdversarialMaxWordsDoesNotCrash(t *testing.T) {
+	d := NewDictionary("abc\ndef\nghi\njkl\n")
+	p := NewPuzzle([4]string{"abc", "def", "ghi", "jkl"})
+	// A maxWords far beyond any real solution should be clamped rather
+	// than recursing to that depth.
+	_ = Solve(d, p, 1_000_000)
+}
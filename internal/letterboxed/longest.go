@@ -0,0 +1,22 @@
+package letterboxed
+
+import "sort"
+
+// LongestWords returns the longest dictionary words playable on p, longest
+// first (ties broken alphabetically), capped at n results. Finding the
+// single longest playable word is the degenerate n=1 case — a "big word"
+// player wants to know the vocabulary ceiling for the box they're looking
+// at, not just a solution.
+func LongestWords(d *Dictionary, p Puzzle, n int) []string {
+	words := allValidWords(d, p)
+	sort.Slice(words, func(i, j int) bool {
+		if len(words[i]) != len(words[j]) {
+			return len(words[i]) > len(words[j])
+		}
+		return words[i] < words[j]
+	})
+	if n > 0 && n < len(words) {
+		words = words[:n]
+	}
+	return words
+}
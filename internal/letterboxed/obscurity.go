@@ -0,0 +1,27 @@
+package letterboxed
+
+import (
+	_ "embed"
+	"strings"
+)
+
+//go:embed assets/common_words.txt
+var commonWordList string
+
+var commonWords = func() map[string]bool {
+	words := make(map[string]bool)
+	for _, w := range strings.Split(commonWordList, "\n") {
+		w = strings.TrimSpace(w)
+		if w != "" {
+			words[w] = true
+		}
+	}
+	return words
+}()
+
+// IsObscure flags word as a likely-unfamiliar suggestion: it's valid but
+// doesn't appear in a everyday-usage word list. It's meant to let hint and
+// suggestion output warn a player before they burn a guess on, say, "cwm".
+func IsObscure(word string) bool {
+	return !commonWords[strings.ToLower(word)]
+}
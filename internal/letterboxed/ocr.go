@@ -0,0 +1,40 @@
+package letterboxed
+
+import (
+	"regexp"
+	"strings"
+)
+
+// letterRun matches a run of 3 or more consecutive letters, the shape an
+// OCR pass or a pasted screenshot caption leaves each puzzle side in once
+// punctuation and whitespace are stripped out.
+var letterRun = regexp.MustCompile(`[a-zA-Z]{3,}`)
+
+// ParseFromText extracts a puzzle spec from loosely-formatted text, such
+// as clipboard contents pasted from a screenshot's OCR output or a share
+// link's caption. It looks for the first four runs of 3+ letters and
+// treats them as the four sides, rather than requiring the strict
+// "abc,def,ghi,jkl" syntax ParseSides expects.
+func ParseFromText(text string) ([4]string, error) {
+	matches := letterRun.FindAllString(text, -1)
+
+	var sides []string
+	for _, m := range matches {
+		if len(m) == 3 {
+			sides = append(sides, m)
+			continue
+		}
+		// A run longer than 3 letters likely means OCR glued two sides
+		// together with no separator between them; split it into
+		// consecutive 3-letter groups rather than discarding it.
+		for len(m) >= 3 {
+			sides = append(sides, m[:3])
+			m = m[3:]
+		}
+	}
+	if len(sides) > 4 {
+		sides = sides[:4]
+	}
+
+	return ParseSides(strings.Join(sides, ","))
+}
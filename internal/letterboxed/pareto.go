@@ -0,0 +1,61 @@
+package letterboxed
+
+// ChainScore summarizes a solved chain along the axes a player might
+// trade off against each other: fewer words, fewer total letters typed,
+// and fewer obscure words are all independently desirable, but rarely all
+// achievable in the same solution.
+type ChainScore struct {
+	WordCount      int
+	TotalLetters   int
+	ObscurityScore int
+}
+
+// ScoreChain computes chain's ChainScore. ObscurityScore is a simple count
+// of words IsObscure flags, not a weighted measure; players who want to
+// avoid any obscure word can just look for a score of zero.
+func ScoreChain(chain []string) ChainScore {
+	score := ChainScore{WordCount: len(chain)}
+	for _, word := range chain {
+		score.TotalLetters += len(word)
+		if IsObscure(word) {
+			score.ObscurityScore++
+		}
+	}
+	return score
+}
+
+// dominates reports whether a is at least as good as b on every axis and
+// strictly better on at least one, using the convention that lower is
+// better across all three ChainScore axes.
+func (a ChainScore) dominates(b ChainScore) bool {
+	if a.WordCount > b.WordCount || a.TotalLetters > b.TotalLetters || a.ObscurityScore > b.ObscurityScore {
+		return false
+	}
+	return a.WordCount < b.WordCount || a.TotalLetters < b.TotalLetters || a.ObscurityScore < b.ObscurityScore
+}
+
+// ParetoFront returns the subset of chains not dominated by any other
+// chain in the set, scored by ScoreChain. This lets a caller offer players
+// a small menu of genuine trade-offs (shortest, fewest letters, safest
+// words) instead of collapsing everything to one ranking.
+func ParetoFront(chains [][]string) [][]string {
+	scores := make([]ChainScore, len(chains))
+	for i, chain := range chains {
+		scores[i] = ScoreChain(chain)
+	}
+
+	var front [][]string
+	for i, chain := range chains {
+		dominated := false
+		for j := range chains {
+			if i != j && scores[j].dominates(scores[i]) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			front = append(front, chain)
+		}
+	}
+	return front
+}
@@ -0,0 +1,43 @@
+package letterboxed
+
+import "testing"
+
+func TestParetoFrontDropsDominatedChains(t *testing.T) {
+	chains := [][]string{
+		{"cat", "taxi"},        // 2 words, 7 letters
+		{"cat", "taxi", "ink"}, // 3 words, 10 letters: strictly worse on both axes
+	}
+
+	front := ParetoFront(chains)
+	if len(front) != 1 {
+		t.Fatalf("ParetoFront(chains) = %v, want exactly the non-dominated 2-word chain", front)
+	}
+	if len(front[0]) != 2 {
+		t.Errorf("ParetoFront(chains)[0] = %v, want the 2-word chain to survive", front[0])
+	}
+}
+
+func TestParetoFrontKeepsIncomparableChains(t *testing.T) {
+	chains := [][]string{
+		{"cat", "taxi"}, // more words (2), fewer letters (7)
+		{"fourteen"},    // fewer words (1), more letters (8): neither dominates the other
+	}
+
+	front := ParetoFront(chains)
+	if len(front) != len(chains) {
+		t.Errorf("ParetoFront(chains) = %v, want both incomparable chains kept", front)
+	}
+}
+
+func TestScoreChainCountsObscureWords(t *testing.T) {
+	score := ScoreChain([]string{"cat", "cwm"})
+	if score.WordCount != 2 {
+		t.Errorf("WordCount = %d, want 2", score.WordCount)
+	}
+	if score.TotalLetters != 6 {
+		t.Errorf("TotalLetters = %d, want 6", score.TotalLetters)
+	}
+	if score.ObscurityScore != 1 {
+		t.Errorf("ObscurityScore = %d, want 1 (cwm is obscure, cat is not)", score.ObscurityScore)
+	}
+}
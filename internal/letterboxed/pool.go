@@ -0,0 +1,39 @@
+package letterboxed
+
+import "sync"
+
+// bufPool recycles the scratch byte buffers used to build candidate words
+// while walking the trie, and chainPool recycles the scratch slices used to
+// build word chains while searching for solutions. Deep searches run these
+// walks many times over, so reusing the backing arrays avoids a steady
+// stream of short-lived allocations.
+var (
+	bufPool = sync.Pool{
+		New: func() any {
+			buf := make([]byte, maxWordLen)
+			return &buf
+		},
+	}
+	chainPool = sync.Pool{
+		New: func() any {
+			chain := make([]string, 0, 8)
+			return &chain
+		},
+	}
+)
+
+func getBuf() []byte {
+	return *bufPool.Get().(*[]byte)
+}
+
+func putBuf(buf []byte) {
+	bufPool.Put(&buf)
+}
+
+func getChain() []string {
+	return (*chainPool.Get().(*[]string))[:0]
+}
+
+func putChain(chain []string) {
+	chainPool.Put(&chain)
+}
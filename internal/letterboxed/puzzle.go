@@ -0,0 +1,49 @@
+// Package letterboxed implements a solver for the NYT Letter Boxed puzzle.
+//
+// A puzzle is a square with three letters on each of its four sides. Words
+// are built by drawing a line from letter to letter; consecutive letters
+// may never come from the same side, and each new word must start with the
+// last letter of the previous word. The puzzle is solved once every letter
+// on the square has appeared in at least one word.
+package letterboxed
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Puzzle holds the four sides of a Letter Boxed square, three letters each.
+type Puzzle struct {
+	Sides [4]string
+}
+
+// NewPuzzle builds a Puzzle from four three-letter sides, lower-casing them
+// for consistent lookups.
+func NewPuzzle(sides [4]string) Puzzle {
+	var p Puzzle
+	for i, s := range sides {
+		p.Sides[i] = strings.ToLower(s)
+	}
+	return p
+}
+
+// sideOf returns the index of the side containing r, or -1 if r is not one
+// of the puzzle's letters.
+func (p Puzzle) sideOf(r byte) int {
+	for i, side := range p.Sides {
+		if strings.IndexByte(side, r) >= 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// Letters returns the set of all twelve letters in the puzzle.
+func (p Puzzle) Letters() string {
+	return p.Sides[0] + p.Sides[1] + p.Sides[2] + p.Sides[3]
+}
+
+// String renders the puzzle as its four sides, for debug output.
+func (p Puzzle) String() string {
+	return fmt.Sprintf("%s-%s-%s-%s", p.Sides[0], p.Sides[1], p.Sides[2], p.Sides[3])
+}
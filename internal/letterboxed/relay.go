@@ -0,0 +1,54 @@
+package letterboxed
+
+// RelayResult is the outcome of solving a two-puzzle relay: a chain that
+// solves the first puzzle, starting the second puzzle's chain from the
+// last letter of the first, so the whole relay reads as one continuous
+// sequence of words.
+type RelayResult struct {
+	First  []string
+	Second []string
+}
+
+// SolveRelay solves a two-puzzle relay: first must be fully solved, then
+// second must be solved starting from the last letter first's final word
+// ended on, the way a relay mode would chain two separate daily puzzles
+// together into one longer challenge.
+func SolveRelay(d *Dictionary, first, second Puzzle, maxWordsEach int) (RelayResult, bool) {
+	firstChains := Solve(d, first, maxWordsEach)
+	if len(firstChains) == 0 {
+		return RelayResult{}, false
+	}
+
+	for _, chain := range firstChains {
+		lastLetter := chain[len(chain)-1][len(chain[len(chain)-1])-1]
+		secondChains := solveFrom(d, second, maxWordsEach, lastLetter)
+		if len(secondChains) > 0 {
+			return RelayResult{First: chain, Second: secondChains[0]}, true
+		}
+	}
+	return RelayResult{}, false
+}
+
+// solveFrom behaves like Solve, but requires the chain's first word to
+// start with startLetter, for continuing a chain begun in a previous
+// puzzle. Every later word starts with the last letter of the word before
+// it, same as Solve.
+func solveFrom(d *Dictionary, p Puzzle, maxWords int, startLetter byte) [][]string {
+	target := fullMask(p)
+	all := allValidWords(d, p)
+
+	var results [][]string
+	for _, first := range validWords(d, p, startLetter) {
+		chain := getChain()
+		chain = append(chain, first)
+		solveHelper(all, target, chain, first[len(first)-1], letterMask(first), 1, maxWords, func(chain []string) bool {
+			solution := make([]string, len(chain))
+			copy(solution, chain)
+			results = append(results, solution)
+			return true
+		})
+		putChain(chain[:0])
+	}
+	sortChains(results)
+	return results
+}
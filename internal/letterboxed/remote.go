@@ -0,0 +1,92 @@
+package letterboxed
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// puzzleSpec is the JSON shape a remote puzzle source is expected to
+// serve: {"sides": ["abc", "def", "ghi", "jkl"]}.
+type puzzleSpec struct {
+	Sides []string `json:"sides"`
+}
+
+// FetchPuzzleFromURL downloads a JSON puzzle spec from url and validates
+// it with the same rules ParseSides applies to a local "abc,def,ghi,jkl"
+// spec. If cacheDir is non-empty, a successful fetch is cached there keyed
+// by a hash of url, and later calls with the same url and cacheDir return
+// the cached puzzle without a network round trip.
+func FetchPuzzleFromURL(url, cacheDir string) (Puzzle, error) {
+	if cacheDir != "" {
+		if cached, ok := readPuzzleCache(cacheDir, url); ok {
+			return cached, nil
+		}
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return Puzzle{}, &InputError{Reason: "fetch puzzle: " + err.Error()}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Puzzle{}, &InputError{Reason: "fetch puzzle: unexpected status " + resp.Status}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Puzzle{}, &InputError{Reason: "fetch puzzle: " + err.Error()}
+	}
+
+	var spec puzzleSpec
+	if err := json.Unmarshal(body, &spec); err != nil {
+		return Puzzle{}, &InputError{Reason: "fetch puzzle: invalid JSON: " + err.Error()}
+	}
+	sides, err := ParseSides(strings.Join(spec.Sides, ","))
+	if err != nil {
+		return Puzzle{}, err
+	}
+	p := NewPuzzle(sides)
+
+	if cacheDir != "" {
+		_ = writePuzzleCache(cacheDir, url, p)
+	}
+	return p, nil
+}
+
+func cacheKey(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(sum[:])
+}
+
+func readPuzzleCache(cacheDir, url string) (Puzzle, bool) {
+	data, err := os.ReadFile(filepath.Join(cacheDir, cacheKey(url)+".json"))
+	if err != nil {
+		return Puzzle{}, false
+	}
+	var spec puzzleSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return Puzzle{}, false
+	}
+	sides, err := ParseSides(strings.Join(spec.Sides, ","))
+	if err != nil {
+		return Puzzle{}, false
+	}
+	return NewPuzzle(sides), true
+}
+
+func writePuzzleCache(cacheDir, url string, p Puzzle) error {
+	if err := os.MkdirAll(cacheDir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(puzzleSpec{Sides: p.Sides[:]})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(cacheDir, cacheKey(url)+".json"), data, 0o644)
+}
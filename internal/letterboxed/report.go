@@ -0,0 +1,94 @@
+package letterboxed
+
+import "sort"
+
+// LetterImportance reports, for each letter on the puzzle, how many
+// playable words contain it. Letters with a low count are the ones worth
+// planning a solution around first, since there are fewer ways to work
+// them in.
+type LetterImportance struct {
+	Letter    byte
+	WordCount int
+}
+
+// MissingWords returns the playable words on p that aren't in known,
+// letting a player who's already tried a handful of words see what else is
+// available without re-deriving the full word list by hand.
+func MissingWords(d *Dictionary, p Puzzle, known []string) []string {
+	tried := make(map[string]bool, len(known))
+	for _, w := range known {
+		tried[w] = true
+	}
+	var missing []string
+	for _, w := range allValidWords(d, p) {
+		if !tried[w] {
+			missing = append(missing, w)
+		}
+	}
+	return missing
+}
+
+// UncoveredLetters returns the puzzle letters that appear in none of known,
+// i.e. the letters a player still needs to work into some word to finish
+// the puzzle.
+func UncoveredLetters(p Puzzle, known []string) string {
+	covered := uint32(0)
+	for _, w := range known {
+		covered |= letterMask(w)
+	}
+	var uncovered []byte
+	for i := 0; i < len(p.Letters()); i++ {
+		letter := p.Letters()[i]
+		if covered&(1<<(letter-'a')) == 0 {
+			uncovered = append(uncovered, letter)
+		}
+	}
+	return string(uncovered)
+}
+
+// SideTransitionHeatmap counts, across every playable word, how many times
+// a letter on side i is immediately followed by a letter on side j.
+// Transitions that barely happen in the dictionary are ones a solver can
+// usually ignore when planning a chain.
+func SideTransitionHeatmap(d *Dictionary, p Puzzle) [4][4]int {
+	var heatmap [4][4]int
+	for _, word := range allValidWords(d, p) {
+		for i := 0; i+1 < len(word); i++ {
+			from := p.sideOf(word[i])
+			to := p.sideOf(word[i+1])
+			heatmap[from][to]++
+		}
+	}
+	return heatmap
+}
+
+// ImportanceReport ranks every letter on p by how many playable words use
+// it, least-supported first.
+func ImportanceReport(d *Dictionary, p Puzzle) []LetterImportance {
+	counts := make(map[byte]int)
+	for _, letter := range p.Letters() {
+		counts[byte(letter)] = 0
+	}
+	for _, word := range allValidWords(d, p) {
+		seen := [26]bool{}
+		for i := 0; i < len(word); i++ {
+			idx := word[i] - 'a'
+			if !seen[idx] {
+				seen[idx] = true
+				counts[word[i]]++
+			}
+		}
+	}
+
+	report := make([]LetterImportance, 0, len(counts))
+	for letter, count := range counts {
+		report = append(report, LetterImportance{Letter: letter, WordCount: count})
+	}
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].WordCount != report[j].WordCount {
+			return report[i].WordCount < report[j].WordCount
+		}
+		return report[i].Letter < report[j].Letter
+	})
+	return report
+}
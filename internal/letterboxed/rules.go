@@ -0,0 +1,121 @@
+package letterboxed
+
+import "strings"
+
+// Rule lets a caller bolt on extra constraints beyond the standard Letter
+// Boxed rules (side-adjacency and letter-chaining), e.g. for house rules or
+// puzzle variants. Allowed is checked every time the search considers
+// appending word to chain.
+type Rule interface {
+	Allowed(chain []string, word string) bool
+}
+
+// RuleFunc adapts a plain function to the Rule interface.
+type RuleFunc func(chain []string, word string) bool
+
+// Allowed implements Rule.
+func (f RuleFunc) Allowed(chain []string, word string) bool { return f(chain, word) }
+
+// NoRepeatWords rejects a word that's already appeared earlier in the
+// chain, matching the NYT's own house rule against reusing a word.
+var NoRepeatWords Rule = RuleFunc(func(chain []string, word string) bool {
+	for _, w := range chain {
+		if w == word {
+			return false
+		}
+	}
+	return true
+})
+
+// MinWordLength rejects any word shorter than n letters, for variants that
+// want a tougher minimum than the standard three.
+func MinWordLength(n int) Rule {
+	return RuleFunc(func(_ []string, word string) bool {
+		return len(word) >= n
+	})
+}
+
+// FirstWordStartsWith rejects any chain whose first word doesn't start with
+// letter, for players who already know how they want to open.
+func FirstWordStartsWith(letter byte) Rule {
+	return RuleFunc(func(chain []string, word string) bool {
+		if len(chain) != 0 {
+			return true
+		}
+		return word[0] == letter
+	})
+}
+
+// AvoidSuffix rejects any word ending in suffix, for players steering away
+// from a form they don't want to see in the solution (e.g. plurals ending
+// in "s").
+func AvoidSuffix(suffix string) Rule {
+	return RuleFunc(func(_ []string, word string) bool {
+		return !strings.HasSuffix(word, suffix)
+	})
+}
+
+// FilterContainingWord keeps only the chains that use word somewhere in
+// them. Unlike the other constructors here, this isn't a Rule: whether a
+// chain will eventually contain word can't be decided by looking at one
+// append at a time, so it's applied as a post-filter over completed chains
+// instead of threaded through the search.
+func FilterContainingWord(chains [][]string, word string) [][]string {
+	var out [][]string
+	for _, chain := range chains {
+		for _, w := range chain {
+			if w == word {
+				out = append(out, chain)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// SolveWithRules behaves like Solve, but only extends a chain with a word
+// when every rule in rules allows it.
+func SolveWithRules(d *Dictionary, p Puzzle, maxWords int, rules []Rule) [][]string {
+	maxWords = clampMaxWords(maxWords)
+	target := fullMask(p)
+	all := allValidWords(d, p)
+
+	var results [][]string
+	chain := getChain()
+	defer putChain(chain)
+
+	var walk func(lastLetter byte, covered uint32, depth int) bool
+	walk = func(lastLetter byte, covered uint32, depth int) bool {
+		if covered == target {
+			solution := make([]string, len(chain))
+			copy(solution, chain)
+			results = append(results, solution)
+			return true
+		}
+		if depth == maxWords {
+			return true
+		}
+		for _, word := range all {
+			if depth > 0 && word[0] != lastLetter {
+				continue
+			}
+			allowed := true
+			for _, rule := range rules {
+				if !rule.Allowed(chain, word) {
+					allowed = false
+					break
+				}
+			}
+			if !allowed {
+				continue
+			}
+			chain = append(chain, word)
+			walk(word[len(word)-1], covered|letterMask(word), depth+1)
+			chain = chain[:len(chain)-1]
+		}
+		return true
+	}
+	walk(0, 0, 0)
+	sortChains(results)
+	return results
+}
@@ -0,0 +1,40 @@
+package letterboxed
+
+import "testing"
+
+func TestFirstWordStartsWithOnlyConstrainsTheOpener(t *testing.T) {
+	rule := FirstWordStartsWith('t')
+
+	if !rule.Allowed(nil, "taxi") {
+		t.Error("Allowed(nil, taxi) = false, want true for a matching opener")
+	}
+	if rule.Allowed(nil, "cat") {
+		t.Error("Allowed(nil, cat) = true, want false for a non-matching opener")
+	}
+	if !rule.Allowed([]string{"taxi"}, "cat") {
+		t.Error("Allowed([taxi], cat) = false, want true once the opener is already fixed")
+	}
+}
+
+func TestAvoidSuffixRejectsMatchingWords(t *testing.T) {
+	rule := AvoidSuffix("s")
+
+	if rule.Allowed(nil, "cats") {
+		t.Error("Allowed(nil, cats) = true, want false")
+	}
+	if !rule.Allowed(nil, "cat") {
+		t.Error("Allowed(nil, cat) = false, want true")
+	}
+}
+
+func TestFilterContainingWord(t *testing.T) {
+	chains := [][]string{
+		{"cat", "taxi"},
+		{"dog", "goat"},
+	}
+
+	got := FilterContainingWord(chains, "taxi")
+	if len(got) != 1 || got[0][1] != "taxi" {
+		t.Errorf("FilterContainingWord(chains, taxi) = %v, want just the chain containing taxi", got)
+	}
+}
@@ -0,0 +1,52 @@
+package letterboxed
+
+import (
+	"fmt"
+	"strings"
+)
+
+// isAlpha reports whether s is non-empty and made up entirely of ASCII
+// letters.
+func isAlpha(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i] | ' ' // lower-case ASCII letters
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseSides validates and normalizes a raw "abc,def,ghi,jkl"-style puzzle
+// spec: exactly four comma-separated sides, each three letters, with no
+// letter repeated across sides. It's the single place query input (CLI
+// flags, daemon requests, HTTP params) is sanitized before becoming a
+// Puzzle, so malformed or hostile input is rejected consistently no matter
+// which entry point it came in through.
+func ParseSides(raw string) ([4]string, error) {
+	var sides [4]string
+	parts := strings.Split(strings.TrimSpace(raw), ",")
+	if len(parts) != 4 {
+		return sides, &InputError{Reason: fmt.Sprintf("need exactly 4 comma-separated sides, got %d", len(parts))}
+	}
+
+	seen := [26]bool{}
+	for i, part := range parts {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if len(part) != 3 || !isAlpha(part) {
+			return sides, &InputError{Reason: fmt.Sprintf("side %q must be exactly 3 letters", parts[i])}
+		}
+		for j := 0; j < len(part); j++ {
+			idx := part[j] - 'a'
+			if seen[idx] {
+				return sides, &InputError{Reason: fmt.Sprintf("letter %q appears more than once", string(part[j]))}
+			}
+			seen[idx] = true
+		}
+		sides[i] = part
+	}
+	return sides, nil
+}
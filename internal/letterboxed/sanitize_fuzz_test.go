@@ -0,0 +1,26 @@
+package letterboxed
+
+import "testing"
+
+// FuzzParseSides exercises ParseSides against arbitrary input to make sure
+// it never panics, regardless of what a CLI flag, daemon request, or HTTP
+// path segment hands it.
+func FuzzParseSides(f *testing.F) {
+	f.Add("abc,def,ghi,jkl")
+	f.Add("")
+	f.Add(",,,")
+	f.Add("ab,cde,fgh,ijk")
+	f.Add("AAA,bbb,ccc,ddd")
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		sides, err := ParseSides(raw)
+		if err != nil {
+			return
+		}
+		for _, side := range sides {
+			if len(side) != 3 || !isAlpha(side) {
+				t.Fatalf("ParseSides(%q) returned invalid side %q with no error", raw, side)
+			}
+		}
+	})
+}
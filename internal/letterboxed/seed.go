@@ -0,0 +1,17 @@
+package letterboxed
+
+import (
+	"hash/fnv"
+	"math/rand"
+)
+
+// seededRand returns a *rand.Rand deterministically derived from key: the
+// same key always produces the same sequence, regardless of machine or
+// process, which is what lets GenerateDaily (and anything else that wants
+// a reproducible "random" puzzle) be reproduced from a human-readable
+// label instead of a raw int64 seed.
+func seededRand(key string) *rand.Rand {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
@@ -0,0 +1,223 @@
+package letterboxed
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxWordLen bounds the scratch buffer used while walking the trie. No
+// English dictionary word comes close to this, so it never truncates a
+// real candidate.
+const maxWordLen = 32
+
+// validWordHelper walks node depth-first, emitting every complete word
+// reachable from it that respects the puzzle's side-adjacency rule (no two
+// consecutive letters may come from the same side). buf is a caller-owned
+// scratch buffer reused across the whole walk so that descending into the
+// trie never allocates; emit is handed a string copy only once a word is
+// found, which is the one allocation per match that's unavoidable. emit
+// returns false to stop the walk early, e.g. once a caller has seen enough
+// matches.
+func validWordHelper(t *Trie, node *trieNode, p Puzzle, buf []byte, depth int, lastSide int, emit func(word string) bool) bool {
+	if node.isWord && depth >= 3 {
+		if !emit(string(buf[:depth])) {
+			return false
+		}
+	}
+	if depth == maxWordLen {
+		return true
+	}
+	for i, childIdx := range node.children {
+		if childIdx == 0 {
+			continue
+		}
+		letter := byte('a' + i)
+		side := p.sideOf(letter)
+		if side == -1 || side == lastSide {
+			continue
+		}
+		buf[depth] = letter
+		if !validWordHelper(t, &t.arena[childIdx], p, buf, depth+1, side, emit) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidWord reports whether word is both in the dictionary and playable
+// on p: at least three letters, every letter on the puzzle, and no two
+// consecutive letters sharing a side.
+func IsValidWord(d *Dictionary, p Puzzle, word string) bool {
+	word = strings.ToLower(word)
+	if len(word) < 3 || !d.has(word) {
+		return false
+	}
+	lastSide := -1
+	for i := 0; i < len(word); i++ {
+		side := p.sideOf(word[i])
+		if side == -1 || side == lastSide {
+			return false
+		}
+		lastSide = side
+	}
+	return true
+}
+
+// AllValidWords returns every dictionary word playable on p, regardless of
+// which letter it starts with.
+func AllValidWords(d *Dictionary, p Puzzle) []string {
+	return allValidWords(d, p)
+}
+
+// ValidWords returns the dictionary words playable on p that start with
+// startLetter.
+func ValidWords(d *Dictionary, p Puzzle, startLetter byte) []string {
+	return validWords(d, p, startLetter)
+}
+
+// Solve searches for chains of dictionary words that cover every letter on
+// the puzzle, using at most maxWords words.
+func Solve(d *Dictionary, p Puzzle, maxWords int) [][]string {
+	return solve(d, p, maxWords)
+}
+
+// NumberOfSolutions reports how many word chains of at most maxWords solve
+// p.
+func NumberOfSolutions(d *Dictionary, p Puzzle, maxWords int) int {
+	return numberOfSolutions(d, p, maxWords)
+}
+
+// allValidWords returns every dictionary word playable on p, regardless of
+// which letter it starts with.
+func allValidWords(d *Dictionary, p Puzzle) []string {
+	var out []string
+	buf := getBuf()
+	defer putBuf(buf)
+	validWordHelper(d.trie, d.trie.root(), p, buf, 0, -1, func(word string) bool {
+		out = append(out, word)
+		return true
+	})
+	return out
+}
+
+// validWords returns the dictionary words playable on p that start with
+// startLetter, e.g. to find continuations after a chain ending in that
+// letter.
+func validWords(d *Dictionary, p Puzzle, startLetter byte) []string {
+	child := d.trie.child(d.trie.root(), startLetter)
+	if child == nil {
+		return nil
+	}
+	side := p.sideOf(startLetter)
+	if side == -1 {
+		return nil
+	}
+	var out []string
+	buf := getBuf()
+	defer putBuf(buf)
+	buf[0] = startLetter
+	validWordHelper(d.trie, child, p, buf, 1, side, func(word string) bool {
+		out = append(out, word)
+		return true
+	})
+	return out
+}
+
+// letterMask returns a 26-bit mask with one bit set per distinct letter in
+// word.
+func letterMask(word string) uint32 {
+	var mask uint32
+	for i := 0; i < len(word); i++ {
+		mask |= 1 << (word[i] - 'a')
+	}
+	return mask
+}
+
+// fullMask is the coverage target: one bit per letter that appears
+// somewhere on the puzzle.
+func fullMask(p Puzzle) uint32 {
+	return letterMask(p.Letters())
+}
+
+// solveHelper walks word chains depth-first, calling emit with each
+// complete solution as soon as it's found. chain is a caller-owned scratch
+// slice; emit must not retain it past the call. emit returns false to stop
+// the search early.
+func solveHelper(all []string, target uint32, chain []string, lastLetter byte, covered uint32, depth, maxWords int, emit func(chain []string) bool) bool {
+	if covered == target {
+		return emit(chain)
+	}
+	if depth == maxWords {
+		return true
+	}
+	for _, word := range all {
+		if depth > 0 && word[0] != lastLetter {
+			continue
+		}
+		chain = append(chain, word)
+		ok := solveHelper(all, target, chain, word[len(word)-1], covered|letterMask(word), depth+1, maxWords, emit)
+		chain = chain[:len(chain)-1]
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// solve searches for chains of dictionary words that cover every letter on
+// the puzzle, using at most maxWords words. Results are sorted by chain
+// length and then lexicographically by word, so the same puzzle and
+// dictionary always produce results in the same order — callers can diff
+// two runs, or compare against a golden file, without worrying about
+// map/goroutine-scheduling nondeterminism creeping in. Use SolveSeq instead
+// if that ordering guarantee isn't needed and chains should stream out as
+// they're found.
+func solve(d *Dictionary, p Puzzle, maxWords int) [][]string {
+	maxWords = clampMaxWords(maxWords)
+	target := fullMask(p)
+	all := allValidWords(d, p)
+
+	var results [][]string
+	if maxWords == 2 {
+		results = twoWordChains(all, target)
+	} else {
+		chain := getChain()
+		defer putChain(chain)
+		solveHelper(all, target, chain, 0, 0, 0, maxWords, func(chain []string) bool {
+			solution := make([]string, len(chain))
+			copy(solution, chain)
+			results = append(results, solution)
+			return true
+		})
+	}
+	sortChains(results)
+	return results
+}
+
+// sortChains orders chains by length first, then lexicographically
+// word-by-word, giving a single canonical ordering for a given set of
+// chains.
+func sortChains(chains [][]string) {
+	sort.Slice(chains, func(i, j int) bool {
+		a, b := chains[i], chains[j]
+		if len(a) != len(b) {
+			return len(a) < len(b)
+		}
+		for k := range a {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return false
+	})
+}
+
+// numberOfSolutions reports how many word chains of at most maxWords solve
+// p, without materializing every chain.
+func numberOfSolutions(d *Dictionary, p Puzzle, maxWords int) int {
+	n := 0
+	for range SolveSeq(d, p, maxWords) {
+		n++
+	}
+	return n
+}
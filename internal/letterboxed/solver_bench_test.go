@@ -0,0 +1,47 @@
+package letterboxed
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+// loadReferencePuzzles reads the benchmark corpus of hand-picked puzzles
+// used to track solver performance across changes, instead of benchmarking
+// against one arbitrary puzzle that might not be representative.
+func loadReferencePuzzles(tb testing.TB) []Puzzle {
+	tb.Helper()
+	f, err := os.Open("testdata/reference_puzzles.txt")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer f.Close()
+
+	var puzzles []Puzzle
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		sides, err := ParseSides(line)
+		if err != nil {
+			tb.Fatalf("bad reference puzzle %q: %v", line, err)
+		}
+		puzzles = append(puzzles, NewPuzzle(sides))
+	}
+	return puzzles
+}
+
+func BenchmarkSolveReferenceCorpus(b *testing.B) {
+	dict := DefaultDictionary()
+	puzzles := loadReferencePuzzles(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, p := range puzzles {
+			Solve(dict, p, 3)
+		}
+	}
+}
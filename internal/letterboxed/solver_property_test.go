@@ -0,0 +1,48 @@
+package letterboxed
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// randomPuzzle builds a Puzzle from a random permutation of the alphabet,
+// split into four sides of three letters each. Every letter is distinct,
+// matching the shape of a real Letter Boxed puzzle.
+func randomPuzzle(rng *rand.Rand) Puzzle {
+	letters := []byte("abcdefghijklmnopqrstuvwxyz")
+	rng.Shuffle(len(letters), func(i, j int) { letters[i], letters[j] = letters[j], letters[i] })
+
+	var sides [4]string
+	for i := range sides {
+		sides[i] = string(letters[i*3 : i*3+3])
+	}
+	return NewPuzzle(sides)
+}
+
+// TestSolvePropertiesOnRandomPuzzles checks invariants that must hold for
+// any puzzle, not just hand-picked ones: every word in a solution must
+// actually be valid for that puzzle, consecutive words must chain by
+// letter, and the solution must cover every letter on the box.
+func TestSolvePropertiesOnRandomPuzzles(t *testing.T) {
+	dict := DefaultDictionary()
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 50; i++ {
+		p := randomPuzzle(rng)
+		for _, chain := range Solve(dict, p, 3) {
+			covered := uint32(0)
+			for j, word := range chain {
+				if !IsValidWord(dict, p, word) {
+					t.Fatalf("puzzle %s: word %q in chain %v is not valid for this puzzle", p, word, chain)
+				}
+				if j > 0 && chain[j-1][len(chain[j-1])-1] != word[0] {
+					t.Fatalf("puzzle %s: chain %v doesn't connect %q to %q", p, chain, chain[j-1], word)
+				}
+				covered |= letterMask(word)
+			}
+			if covered != fullMask(p) {
+				t.Fatalf("puzzle %s: chain %v doesn't cover every letter", p, chain)
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package letterboxed
+
+import (
+	"database/sql"
+
+	_ "modernc.org/sqlite"
+)
+
+// NewDictionaryFromSQLite loads a Dictionary from a SQLite database at
+// path, reading words from a table named "words" with a "word" text
+// column. It's an alternative to the embedded word list for callers who
+// want to swap dictionaries (e.g. per-language or per-difficulty) without
+// rebuilding the binary.
+func NewDictionaryFromSQLite(path string) (*Dictionary, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, &DictionaryError{Op: "open sqlite dictionary", Err: err}
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT word FROM words")
+	if err != nil {
+		return nil, &DictionaryError{Op: "query sqlite dictionary", Err: err}
+	}
+	defer rows.Close()
+
+	d := &Dictionary{trie: NewTrie(), bloom: newBloomFilter(0)}
+	for rows.Next() {
+		var word string
+		if err := rows.Scan(&word); err != nil {
+			return nil, &DictionaryError{Op: "scan sqlite dictionary row", Err: err}
+		}
+		d.AddWord(word)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, &DictionaryError{Op: "read sqlite dictionary", Err: err}
+	}
+	d.rebuildBloom()
+	return d, nil
+}
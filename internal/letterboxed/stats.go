@@ -0,0 +1,68 @@
+package letterboxed
+
+// SearchStats summarizes how much work a solve took, for callers that want
+// to report or tune search performance rather than just get an answer.
+type SearchStats struct {
+	// WordsConsidered is the size of the playable-word set the search
+	// chained over.
+	WordsConsidered int
+	// ChainsExplored counts every partial chain the search extended,
+	// including ones that were later abandoned.
+	ChainsExplored int
+	// SolutionsFound is the number of complete chains that covered the
+	// puzzle.
+	SolutionsFound int
+}
+
+// SolveWithStats behaves like Solve, but also reports search-effort
+// counters alongside the results.
+func SolveWithStats(d *Dictionary, p Puzzle, maxWords int) ([][]string, SearchStats) {
+	maxWords = clampMaxWords(maxWords)
+	target := fullMask(p)
+	all := allValidWords(d, p)
+	stats := SearchStats{WordsConsidered: len(all)}
+
+	var results [][]string
+	if maxWords == 2 {
+		results = twoWordChains(all, target)
+		stats.ChainsExplored = len(all)
+	} else {
+		chain := getChain()
+		defer putChain(chain)
+		countingHelper(all, target, chain, 0, 0, 0, maxWords, &stats, func(c []string) bool {
+			solution := make([]string, len(c))
+			copy(solution, c)
+			results = append(results, solution)
+			return true
+		})
+	}
+	sortChains(results)
+	stats.SolutionsFound = len(results)
+	return results, stats
+}
+
+// countingHelper is solveHelper with a ChainsExplored counter threaded
+// through, kept as its own function so the hot, uninstrumented solveHelper
+// path used by Solve and SolveSeq doesn't pay for bookkeeping it doesn't
+// need.
+func countingHelper(all []string, target uint32, chain []string, lastLetter byte, covered uint32, depth, maxWords int, stats *SearchStats, emit func(chain []string) bool) bool {
+	if covered == target {
+		return emit(chain)
+	}
+	if depth == maxWords {
+		return true
+	}
+	for _, word := range all {
+		if depth > 0 && word[0] != lastLetter {
+			continue
+		}
+		stats.ChainsExplored++
+		chain = append(chain, word)
+		ok := countingHelper(all, target, chain, word[len(word)-1], covered|letterMask(word), depth+1, maxWords, stats, emit)
+		chain = chain[:len(chain)-1]
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package letterboxed
+
+// trieNode is one node of a lowercase a-z letter trie. children holds
+// indices into the owning Trie's arena; 0 means "no child", since index 0
+// is always the arena's root.
+type trieNode struct {
+	children [26]int32
+	isWord   bool
+}
+
+// Trie is a prefix tree over the dictionary, used to walk letter-by-letter
+// candidates without re-scanning the whole word list for every puzzle.
+//
+// Nodes live in a single growable arena slice rather than being allocated
+// one at a time: a dictionary of any real size creates hundreds of
+// thousands of nodes, and letting each be its own heap object both costs an
+// allocation per node and scatters the trie across memory, which is
+// unfriendly to the cache during a deep recursive walk.
+type Trie struct {
+	arena []trieNode
+}
+
+// NewTrie returns an empty Trie, with its root pre-allocated at index 0.
+func NewTrie() *Trie {
+	return &Trie{arena: []trieNode{{}}}
+}
+
+func (t *Trie) root() *trieNode {
+	return &t.arena[0]
+}
+
+// alloc appends a fresh node to the arena and returns its index.
+func (t *Trie) alloc() int32 {
+	t.arena = append(t.arena, trieNode{})
+	return int32(len(t.arena) - 1)
+}
+
+// Insert adds word to the trie. Only lowercase a-z bytes are supported;
+// callers are expected to normalize the dictionary up front.
+func (t *Trie) Insert(word string) {
+	idx := int32(0)
+	for i := 0; i < len(word); i++ {
+		c := word[i] - 'a'
+		if c > 25 {
+			return
+		}
+		next := t.arena[idx].children[c]
+		if next == 0 {
+			next = t.alloc()
+			t.arena[idx].children[c] = next
+		}
+		idx = next
+	}
+	t.arena[idx].isWord = true
+}
+
+// Remove unmarks word as a dictionary entry. It leaves the node itself in
+// the arena — dictionary edits are rare compared to lookups, so there's no
+// need to compact the arena or chase down now-unreachable nodes for the
+// sake of a word that will likely be re-added or looked up again soon.
+func (t *Trie) Remove(word string) {
+	idx := int32(0)
+	for i := 0; i < len(word); i++ {
+		c := word[i] - 'a'
+		if c > 25 {
+			return
+		}
+		idx = t.arena[idx].children[c]
+		if idx == 0 {
+			return
+		}
+	}
+	t.arena[idx].isWord = false
+}
+
+// Has reports whether word was inserted into the trie.
+func (t *Trie) Has(word string) bool {
+	idx := int32(0)
+	for i := 0; i < len(word); i++ {
+		c := word[i] - 'a'
+		if c > 25 {
+			return false
+		}
+		idx = t.arena[idx].children[c]
+		if idx == 0 {
+			return false
+		}
+	}
+	return t.arena[idx].isWord
+}
+
+// child returns the node reached from node by letter, or nil if there is
+// no such edge. node must belong to t.
+func (t *Trie) child(node *trieNode, letter byte) *trieNode {
+	idx := node.children[letter-'a']
+	if idx == 0 {
+		return nil
+	}
+	return &t.arena[idx]
+}
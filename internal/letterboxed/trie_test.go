@@ -0,0 +1,101 @@
+package letterboxed
+
+import "testing"
+
+func TestTrieInsertAndHas(t *testing.T) {
+	cases := []struct {
+		name    string
+		inserts []string
+		lookup  string
+		want    bool
+	}{
+		{"exact match", []string{"cat"}, "cat", true},
+		{"prefix is not a word", []string{"cats"}, "cat", false},
+		{"not inserted", []string{"cat"}, "dog", false},
+		{"empty trie", nil, "cat", false},
+		{"one of several words", []string{"cat", "dog", "bird"}, "dog", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			trie := NewTrie()
+			for _, w := range tc.inserts {
+				trie.Insert(w)
+			}
+			if got := trie.Has(tc.lookup); got != tc.want {
+				t.Errorf("Has(%q) = %v, want %v", tc.lookup, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTrieRemove(t *testing.T) {
+	trie := NewTrie()
+	trie.Insert("cat")
+	trie.Insert("catalog")
+
+	trie.Remove("cat")
+
+	if trie.Has("cat") {
+		t.Error("Has(\"cat\") = true after Remove, want false")
+	}
+	if !trie.Has("catalog") {
+		t.Error("Has(\"catalog\") = false after removing an unrelated prefix, want true")
+	}
+}
+
+func TestTrieRemoveMissingWordIsNoOp(t *testing.T) {
+	trie := NewTrie()
+	trie.Insert("cat")
+
+	trie.Remove("dog")
+
+	if !trie.Has("cat") {
+		t.Error("Remove of a word never inserted affected an unrelated word")
+	}
+}
+
+func TestDictionaryFixtures(t *testing.T) {
+	cases := []struct {
+		name     string
+		wordList string
+		want     []string
+		reject   []string
+	}{
+		{
+			name:     "filters short and non-letter entries",
+			wordList: "cat\nab\n123\ndog\n",
+			want:     []string{"cat", "dog"},
+			reject:   []string{"ab", "123"},
+		},
+		{
+			name:     "lower-cases and trims entries",
+			wordList: "  CAT  \nDog\n",
+			want:     []string{"cat", "dog"},
+		},
+		{
+			name:     "empty list",
+			wordList: "",
+			want:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewDictionary(tc.wordList)
+			if got := d.Len(); got != len(tc.want) {
+				t.Fatalf("Len() = %d, want %d", got, len(tc.want))
+			}
+			for _, w := range tc.want {
+				if !d.trie.Has(w) {
+					t.Errorf("expected word %q to be in the dictionary", w)
+				}
+			}
+			for _, w := range tc.reject {
+				if d.trie.Has(w) {
+					t.Errorf("word %q should have been filtered out", w)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package letterboxed
+
+// CheckSolvable does a cheap necessary-condition check for whether p can be
+// solved at all against d: every letter must appear in at least one
+// playable word, since a letter no word ever uses can never be covered. It
+// doesn't run the full solver, so it can't prove a puzzle is solvable, only
+// catch some puzzles that definitely aren't.
+func CheckSolvable(d *Dictionary, p Puzzle) error {
+	counts := make(map[byte]int)
+	for _, letter := range p.Letters() {
+		counts[byte(letter)] = 0
+	}
+	for _, word := range allValidWords(d, p) {
+		for i := 0; i < len(word); i++ {
+			counts[word[i]]++
+		}
+	}
+	for letter, count := range counts {
+		if count == 0 {
+			return &UnsolvableError{Puzzle: p, Letter: letter}
+		}
+	}
+	return nil
+}
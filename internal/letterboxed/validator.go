@@ -0,0 +1,45 @@
+package letterboxed
+
+// Validator decides whether a word is an accepted solution according to
+// some source of truth. It exists so code that needs an authoritative
+// accept/reject decision can depend on an interface instead of reaching
+// for a network call directly, which keeps that code testable offline.
+//
+// This tree has no implementation that actually calls out to NYT's
+// servers — nyt-games only ever consults its own dictionary, the same way
+// FetchPuzzleFromURL only fetches puzzle specs, not word decisions — so
+// DictionaryValidator below is the closest real stand-in, and
+// RecordedValidator is a fake for tests that want to simulate specific
+// accept/reject behavior (including cases where it disagrees with the
+// local dictionary) without any of that.
+type Validator interface {
+	IsAccepted(word string) (bool, error)
+}
+
+// DictionaryValidator treats membership in Dict as the acceptance
+// decision.
+type DictionaryValidator struct {
+	Dict *Dictionary
+}
+
+// IsAccepted implements Validator.
+func (v DictionaryValidator) IsAccepted(word string) (bool, error) {
+	return v.Dict.has(word), nil
+}
+
+// RecordedValidator is a Validator backed by a fixed map of canned
+// responses, for testing code that depends on a Validator without a real
+// network call or session cookie. A word not present in Responses falls
+// back to Default.
+type RecordedValidator struct {
+	Responses map[string]bool
+	Default   bool
+}
+
+// IsAccepted implements Validator.
+func (r RecordedValidator) IsAccepted(word string) (bool, error) {
+	if accepted, ok := r.Responses[word]; ok {
+		return accepted, nil
+	}
+	return r.Default, nil
+}
@@ -0,0 +1,36 @@
+package letterboxed
+
+import "testing"
+
+func TestDictionaryValidatorMatchesDictionaryMembership(t *testing.T) {
+	d := NewDictionary("cat\n")
+	v := DictionaryValidator{Dict: d}
+
+	if accepted, err := v.IsAccepted("cat"); err != nil || !accepted {
+		t.Errorf("IsAccepted(cat) = (%v, %v), want (true, nil)", accepted, err)
+	}
+	if accepted, err := v.IsAccepted("dog"); err != nil || accepted {
+		t.Errorf("IsAccepted(dog) = (%v, %v), want (false, nil)", accepted, err)
+	}
+}
+
+func TestRecordedValidatorFallsBackToDefault(t *testing.T) {
+	v := RecordedValidator{
+		Responses: map[string]bool{"cat": true, "xyzzy": false},
+		Default:   true,
+	}
+
+	cases := []struct {
+		word string
+		want bool
+	}{
+		{"cat", true},
+		{"xyzzy", false},
+		{"unrecorded", true},
+	}
+	for _, c := range cases {
+		if got, err := v.IsAccepted(c.word); err != nil || got != c.want {
+			t.Errorf("IsAccepted(%q) = (%v, %v), want (%v, nil)", c.word, got, err, c.want)
+		}
+	}
+}
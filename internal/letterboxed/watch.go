@@ -0,0 +1,52 @@
+package letterboxed
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// NewDictionaryFromFile loads a Dictionary from a newline-separated word
+// list file at path, the on-disk counterpart to NewDictionary for callers
+// who want to point at a word list without embedding it in the binary.
+func NewDictionaryFromFile(path string) (*Dictionary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, &DictionaryError{Op: "read dictionary file", Err: err}
+	}
+	return NewDictionary(string(data)), nil
+}
+
+// WatchDictionaryFile polls path for modifications every interval and,
+// whenever its mtime changes, reloads it and calls onReload with the
+// freshly built Dictionary. Polling (rather than a filesystem-event
+// watcher) keeps this dependency-free and is more than responsive enough
+// for a word list that changes at most a few times a day. It blocks until
+// stop is closed.
+func WatchDictionaryFile(path string, interval time.Duration, stop <-chan struct{}, onReload func(*Dictionary)) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("letterboxed: watch dictionary file: %w", err)
+	}
+	lastMod := info.ModTime()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+			info, err := os.Stat(path)
+			if err != nil || info.ModTime().Equal(lastMod) {
+				continue
+			}
+			dict, err := NewDictionaryFromFile(path)
+			if err != nil {
+				continue
+			}
+			lastMod = info.ModTime()
+			onReload(dict)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Package notify delivers short "a background task finished" notifications
+// without a cgo or third-party dependency, by shelling out to the
+// platform's native notifier and falling back to a terminal bell when none
+// is available.
+package notify
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+)
+
+// Notify delivers a desktop notification with title and message. If no
+// desktop notifier is available for the current platform (headless Linux,
+// an unsupported OS, SSH without a display), it falls back to ringing the
+// terminal bell and printing the summary to out instead, so the caller
+// always gets some signal that the task finished.
+func Notify(out io.Writer, title, message string) {
+	if err := desktopNotify(title, message); err != nil {
+		fmt.Fprintf(out, "\a%s: %s\n", title, message)
+	}
+}
+
+func desktopNotify(title, message string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %q with title %q", message, title)
+		return exec.Command("osascript", "-e", script).Run()
+	case "linux":
+		return exec.Command("notify-send", title, message).Run()
+	default:
+		return fmt.Errorf("notify: no desktop notifier for %s", runtime.GOOS)
+	}
+}
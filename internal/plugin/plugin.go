@@ -0,0 +1,52 @@
+// Package plugin defines a small registry for pluggable NYT-style games:
+// anything that wants to hang off the same CLI/daemon scaffolding as
+// Letter Boxed (load a puzzle, check a guess, report solved/unsolved)
+// without the core packages needing to know about it ahead of time.
+package plugin
+
+import "fmt"
+
+// Game is the interface a custom game plugin implements to participate in
+// the shared CLI/daemon scaffolding.
+type Game interface {
+	// Name identifies the game, e.g. for a --game=<name> CLI flag.
+	Name() string
+	// NewPuzzle parses a puzzle spec string (the game's own format) into
+	// an opaque puzzle handle.
+	NewPuzzle(spec string) (any, error)
+	// CheckGuess reports whether guess is correct against puzzle, and a
+	// human-readable message to show the player either way.
+	CheckGuess(puzzle any, guess string) (correct bool, message string)
+	// Solved reports whether puzzle has been fully solved given the
+	// guesses made so far.
+	Solved(puzzle any, guesses []string) bool
+}
+
+var registry = make(map[string]Game)
+
+// Register adds a game to the registry under its own Name(). Registering
+// two games under the same name is a programming error and panics,
+// matching the database/sql driver registration pattern.
+func Register(g Game) {
+	name := g.Name()
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("plugin: game %q already registered", name))
+	}
+	registry[name] = g
+}
+
+// Lookup returns the registered game with the given name, or ok == false
+// if none was registered under it.
+func Lookup(name string) (Game, bool) {
+	g, ok := registry[name]
+	return g, ok
+}
+
+// Names returns the names of every registered game.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,105 @@
+// Package recording captures a REPL session to a JSON file and plays it
+// back later, for sharing solves and for reproducing bugs.
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Entry is one command typed during a session and the output it produced.
+type Entry struct {
+	At      time.Time `json:"at"`
+	Command string    `json:"command"`
+	Output  string    `json:"output"`
+}
+
+// Session is a full recorded transcript, in entry order.
+type Session struct {
+	Entries []Entry `json:"entries"`
+}
+
+// Recorder wraps an io.Writer, tagging everything written between
+// successive calls to BeginCommand as the output of the command most
+// recently begun.
+type Recorder struct {
+	out     io.Writer
+	session Session
+	buf     bytes.Buffer
+	command string
+	at      time.Time
+}
+
+// NewRecorder wraps out so writes still reach it, while also being
+// collected into a Session for later Save.
+func NewRecorder(out io.Writer) *Recorder {
+	return &Recorder{out: out}
+}
+
+// Write implements io.Writer, passing bytes through to the wrapped writer
+// while also buffering them as the current command's output.
+func (r *Recorder) Write(p []byte) (int, error) {
+	r.buf.Write(p)
+	return r.out.Write(p)
+}
+
+// BeginCommand closes out the entry for whatever command was previously in
+// progress and starts a new one for command, timestamped now.
+func (r *Recorder) BeginCommand(command string) {
+	r.flush()
+	r.command = command
+	r.at = time.Now()
+}
+
+func (r *Recorder) flush() {
+	if r.command == "" && r.buf.Len() == 0 {
+		return
+	}
+	r.session.Entries = append(r.session.Entries, Entry{At: r.at, Command: r.command, Output: r.buf.String()})
+	r.buf.Reset()
+	r.command = ""
+}
+
+// Save flushes any in-progress entry and writes the recorded session as
+// JSON to path.
+func (r *Recorder) Save(path string) error {
+	r.flush()
+	data, err := json.MarshalIndent(r.session, "", "  ")
+	if err != nil {
+		return fmt.Errorf("recording: marshal session: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("recording: write session file: %w", err)
+	}
+	return nil
+}
+
+// Replay reads a session recorded with Recorder from path and writes its
+// commands and their output to out, in order. If realTime is true, it
+// sleeps between entries to reproduce the original pacing; otherwise it
+// plays back as fast as possible.
+func Replay(path string, out io.Writer, realTime bool) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("recording: read session file: %w", err)
+	}
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return fmt.Errorf("recording: parse session file: %w", err)
+	}
+
+	var prev time.Time
+	for i, e := range session.Entries {
+		if realTime && i > 0 {
+			time.Sleep(e.At.Sub(prev))
+		}
+		fmt.Fprintf(out, "> %s\n", e.Command)
+		fmt.Fprint(out, e.Output)
+		prev = e.At
+	}
+	return nil
+}
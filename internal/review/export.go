@@ -0,0 +1,21 @@
+package review
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ExportVocabulary writes every word played this session to path, one per
+// line, so a player can build up a personal study list across sessions
+// instead of losing their finds when the program exits.
+func (s *Session) ExportVocabulary(path string) error {
+	content := strings.Join(s.Words(), "\n")
+	if content != "" {
+		content += "\n"
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("review: export vocabulary: %w", err)
+	}
+	return nil
+}
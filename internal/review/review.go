@@ -0,0 +1,69 @@
+// Package review analyzes a completed play session after the fact: how
+// long the player paused between guesses, where the long pauses were, and
+// so on.
+package review
+
+import "time"
+
+// Guess is one word entered during a session, with the time it was
+// entered.
+type Guess struct {
+	Word string
+	At   time.Time
+}
+
+// Pause describes the gap before a guess.
+type Pause struct {
+	Word   string
+	Before time.Duration
+}
+
+// Session accumulates guesses as a game is played.
+type Session struct {
+	guesses []Guess
+}
+
+// Record appends a guess at the current time.
+func (s *Session) Record(word string) {
+	s.guesses = append(s.guesses, Guess{Word: word, At: time.Now()})
+}
+
+// Words returns every word recorded so far, in entry order.
+func (s *Session) Words() []string {
+	words := make([]string, len(s.guesses))
+	for i, g := range s.guesses {
+		words[i] = g.Word
+	}
+	return words
+}
+
+// Pauses returns the gap before each guess after the first, in entry order.
+func (s *Session) Pauses() []Pause {
+	if len(s.guesses) < 2 {
+		return nil
+	}
+	pauses := make([]Pause, 0, len(s.guesses)-1)
+	for i := 1; i < len(s.guesses); i++ {
+		pauses = append(pauses, Pause{
+			Word:   s.guesses[i].Word,
+			Before: s.guesses[i].At.Sub(s.guesses[i-1].At),
+		})
+	}
+	return pauses
+}
+
+// LongestPause returns the single biggest gap between consecutive guesses.
+// ok is false if there weren't at least two guesses to compare.
+func (s *Session) LongestPause() (Pause, bool) {
+	pauses := s.Pauses()
+	if len(pauses) == 0 {
+		return Pause{}, false
+	}
+	longest := pauses[0]
+	for _, p := range pauses[1:] {
+		if p.Before > longest.Before {
+			longest = p
+		}
+	}
+	return longest, true
+}
@@ -0,0 +1,53 @@
+// Package scripting lets strategies be written as small Starlark scripts
+// instead of compiled Go, so a player can try out a custom search
+// constraint without rebuilding the binary.
+package scripting
+
+import (
+	"fmt"
+	"os"
+
+	"go.starlark.net/starlark"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// ScriptRule loads a Starlark script from path and adapts its top-level
+// `allowed(chain, word)` function, which must return a bool, into a
+// letterboxed.Rule. The script is executed once at load; every Allowed call
+// then calls its `allowed` function through the Starlark interpreter, which
+// is slow compared to a native Go rule — fine for a hand-written house
+// rule, not for anything in the hot path of a large batch solve.
+func ScriptRule(path string) (letterboxed.Rule, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("scripting: read rule script: %w", err)
+	}
+
+	thread := &starlark.Thread{Name: "rule"}
+	globals, err := starlark.ExecFile(thread, path, src, nil)
+	if err != nil {
+		return nil, fmt.Errorf("scripting: load rule script: %w", err)
+	}
+
+	fn, ok := globals["allowed"].(*starlark.Function)
+	if !ok {
+		return nil, fmt.Errorf("scripting: %s does not define an `allowed` function", path)
+	}
+
+	return letterboxed.RuleFunc(func(chain []string, word string) bool {
+		chainList := make([]starlark.Value, len(chain))
+		for i, w := range chain {
+			chainList[i] = starlark.String(w)
+		}
+		result, err := starlark.Call(thread, fn, starlark.Tuple{
+			starlark.NewList(chainList),
+			starlark.String(word),
+		}, nil)
+		if err != nil {
+			return false
+		}
+		truth, ok := result.(starlark.Bool)
+		return ok && bool(truth)
+	}), nil
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// overrideEntry records one admin correction applied to a dictionary, so a
+// group-hosted instance's moderation history can be inspected later.
+type overrideEntry struct {
+	Word   string    `json:"word"`
+	Action string    `json:"action"` // "ban" or "learn"
+	Dict   string    `json:"dict,omitempty"`
+	At     time.Time `json:"at"`
+}
+
+// overrides tracks the history of admin-applied word bans and learns.
+type overrides struct {
+	mu      sync.Mutex
+	history []overrideEntry
+}
+
+func (o *overrides) record(e overrideEntry) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.history = append(o.history, e)
+}
+
+func (o *overrides) list() []overrideEntry {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]overrideEntry(nil), o.history...)
+}
+
+// EnableAdminAPI registers the admin endpoints for banning and learning
+// words and inspecting the override history, behind adminKeys (checked the
+// same way as the regular API's X-API-Key, but as a separate key set so
+// admin access can be granted independently of solver access).
+func (s *Server) EnableAdminAPI(adminKeys map[string]bool) {
+	limiter := newRateLimiter(60, time.Minute)
+	admin := http.NewServeMux()
+	admin.Handle("POST /admin/v1/ban", withMetrics("admin_ban", http.HandlerFunc(s.handleAdminBan)))
+	admin.Handle("POST /admin/v1/learn", withMetrics("admin_learn", http.HandlerFunc(s.handleAdminLearn)))
+	admin.Handle("GET /admin/v1/overrides", withMetrics("admin_overrides", http.HandlerFunc(s.handleAdminOverrides)))
+	s.mux.Handle("/admin/", withAPIKeyAndRateLimit(adminKeys, limiter, admin))
+}
+
+type overrideRequest struct {
+	Word string `json:"word"`
+	Dict string `json:"dict"`
+}
+
+func (s *Server) handleAdminBan(w http.ResponseWriter, r *http.Request) {
+	var req overrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := s.editDict(req.Dict, func(d *letterboxed.Dictionary) { d.RemoveWord(req.Word) }); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	s.overrides.record(overrideEntry{Word: req.Word, Action: "ban", Dict: req.Dict, At: time.Now()})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleAdminLearn(w http.ResponseWriter, r *http.Request) {
+	var req overrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := s.editDict(req.Dict, func(d *letterboxed.Dictionary) { d.AddWord(req.Word) }); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	s.overrides.record(overrideEntry{Word: req.Word, Action: "learn", Dict: req.Dict, At: time.Now()})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleAdminOverrides(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.overrides.list())
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// TestConcurrentSolveAndAdminBan drives handleSolve and handleAdminBan at
+// the same time, the way net/http would for two simultaneous requests.
+// Before editDict existed, admin.go mutated the shared *Dictionary in
+// place, racing with a solve walking the same trie; run with -race, that
+// used to be flagged as a data race.
+func TestConcurrentSolveAndAdminBan(t *testing.T) {
+	dict := letterboxed.NewDictionary("cab\nbead\nabe\ndab\n")
+	s := New(dict, map[string]bool{"k": true}, 1000)
+	s.EnableAdminAPI(map[string]bool{"admin-k": true})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest("GET", "/api/v1/puzzle/abc,def,ghi,jkl/solve", nil)
+			req.Header.Set("X-API-Key", "k")
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+		}()
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest("POST", "/admin/v1/ban", strings.NewReader(`{"word":"bead"}`))
+			req.Header.Set("X-API-Key", "admin-k")
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+		}()
+	}
+	wg.Wait()
+}
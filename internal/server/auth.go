@@ -0,0 +1,62 @@
+package server
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter caps each API key to maxRequests per window, using a simple
+// fixed-window counter per key. It's not as smooth as a token bucket, but
+// it's trivial to reason about and cheap enough for a single-process
+// server.
+type rateLimiter struct {
+	mu         sync.Mutex
+	maxPerWin  int
+	window     time.Duration
+	counts     map[string]int
+	windowedAt map[string]time.Time
+}
+
+func newRateLimiter(maxPerWindow int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		maxPerWin:  maxPerWindow,
+		window:     window,
+		counts:     make(map[string]int),
+		windowedAt: make(map[string]time.Time),
+	}
+}
+
+func (rl *rateLimiter) allow(key string) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	if start, ok := rl.windowedAt[key]; !ok || now.Sub(start) >= rl.window {
+		rl.windowedAt[key] = now
+		rl.counts[key] = 0
+	}
+	if rl.counts[key] >= rl.maxPerWin {
+		return false
+	}
+	rl.counts[key]++
+	return true
+}
+
+// withAPIKeyAndRateLimit wraps next so that requests must present a known
+// API key (in the X-API-Key header) and stay within the configured rate
+// limit for that key.
+func withAPIKeyAndRateLimit(validKeys map[string]bool, limiter *rateLimiter, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("X-API-Key")
+		if !validKeys[key] {
+			writeError(w, http.StatusUnauthorized, errInvalidAPIKey)
+			return
+		}
+		if !limiter.allow(key) {
+			writeError(w, http.StatusTooManyRequests, errRateLimited)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
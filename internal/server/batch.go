@@ -0,0 +1,52 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// batchPuzzleRequest is one entry of a batch solve request body: the same
+// shape repeated once per puzzle, decoded one at a time so an arbitrarily
+// large archive can be streamed in without buffering the whole request.
+type batchPuzzleRequest struct {
+	Sides [4]string `json:"sides"`
+}
+
+// handleBatchSolve solves every puzzle in the request body and writes each
+// result back as a line of NDJSON as soon as it's solved, so a researcher
+// running an archive-wide analysis sees results stream in instead of
+// waiting for the whole batch to finish before getting any answer.
+func (s *Server) handleBatchSolve(w http.ResponseWriter, r *http.Request) {
+	dict, err := s.dictFor(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	enc := json.NewEncoder(w)
+	flusher, _ := w.(http.Flusher)
+
+	dec := json.NewDecoder(bufio.NewReader(r.Body))
+	for dec.More() {
+		var req batchPuzzleRequest
+		if err := dec.Decode(&req); err != nil {
+			_ = enc.Encode(map[string]string{"error": err.Error()})
+			return
+		}
+		sides, err := letterboxed.ParseSides(strings.Join(req.Sides[:], ","))
+		if err != nil {
+			_ = enc.Encode(map[string]string{"error": err.Error()})
+			continue
+		}
+		p := letterboxed.NewPuzzle(sides)
+		_ = enc.Encode(solveResponse{Sides: p.Sides, Solutions: letterboxed.Solve(dict, p, 3)})
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	cacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "nytgames_solve_cache_hits_total",
+		Help: "Solve requests answered from the response cache instead of recomputing.",
+	})
+	cacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "nytgames_solve_cache_misses_total",
+		Help: "Solve requests that required a fresh computation.",
+	})
+)
+
+// solveCache is a fixed-capacity LRU cache keyed by (dictionary, puzzle)
+// so repeated solves of the same daily puzzle, the common case in server
+// mode, cost one computation instead of one per request.
+type solveCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type cacheEntry struct {
+	key   string
+	value solveResponse
+}
+
+func newSolveCache(capacity int) *solveCache {
+	return &solveCache{capacity: capacity, items: make(map[string]*list.Element), order: list.New()}
+}
+
+func (c *solveCache) get(key string) (solveResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		cacheMisses.Inc()
+		return solveResponse{}, false
+	}
+	c.order.MoveToFront(el)
+	cacheHits.Inc()
+	return el.Value.(*cacheEntry).value, true
+}
+
+func (c *solveCache) put(key string, value solveResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		el.Value.(*cacheEntry).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+	el := c.order.PushFront(&cacheEntry{key: key, value: value})
+	c.items[key] = el
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
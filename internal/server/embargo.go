@@ -0,0 +1,85 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+// embargo withholds full solutions for the current day's daily puzzle
+// until a fixed local time each day, so a server shared by a group doesn't
+// spoil the puzzle for players who haven't gotten to it yet.
+type embargo struct {
+	set          bool
+	hour, minute int
+}
+
+// SetEmbargo configures the server to withhold full solutions to the
+// current day's daily puzzle until hour:minute local time; solve requests
+// for that puzzle before then get a hint instead. Puzzles other than
+// today's daily (e.g. a custom --sides puzzle) are never embargoed.
+func (s *Server) SetEmbargo(hour, minute int) {
+	s.embargo = embargo{set: true, hour: hour, minute: minute}
+}
+
+// active reports whether p is today's daily puzzle and the embargo time
+// hasn't passed yet in now's location.
+func (e embargo) active(dict *letterboxed.Dictionary, p letterboxed.Puzzle, now time.Time) bool {
+	if !e.set {
+		return false
+	}
+	daily, err := letterboxed.GenerateDaily(dict, now)
+	if err != nil || daily != p {
+		return false
+	}
+	lift := time.Date(now.Year(), now.Month(), now.Day(), e.hour, e.minute, 0, 0, now.Location())
+	return now.Before(lift)
+}
+
+type embargoedResponse struct {
+	Sides   [4]string `json:"sides"`
+	Embargo string    `json:"embargo"`
+	Hint    string    `json:"hint,omitempty"`
+}
+
+func (s *Server) handleSolve(w http.ResponseWriter, r *http.Request) {
+	dict, err := s.dictFor(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	sides, err := letterboxed.ParseSides(r.PathValue("sides"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	p := letterboxed.NewPuzzle(sides)
+
+	if s.embargo.active(dict, p, time.Now()) {
+		hint, _ := letterboxed.HintAtLevel(dict, p, nil, letterboxed.HintFirstLetter)
+		writeJSON(w, http.StatusOK, embargoedResponse{
+			Sides:   p.Sides,
+			Embargo: "full solutions are embargoed until the daily puzzle's reveal time; here's a hint instead",
+			Hint:    hint,
+		})
+		return
+	}
+
+	cacheKey := r.URL.Query().Get("dict") + "|" + p.String()
+	if s.cache != nil {
+		if cached, ok := s.cache.get(cacheKey); ok {
+			writeJSON(w, http.StatusOK, cached)
+			return
+		}
+	}
+
+	response := solveResponse{
+		Sides:     p.Sides,
+		Solutions: letterboxed.Solve(dict, p, 3),
+	}
+	if s.cache != nil {
+		s.cache.put(cacheKey, response)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
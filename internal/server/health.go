@@ -0,0 +1,35 @@
+package server
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+// warmup tracks whether the dictionary has finished loading, so readiness
+// checks can distinguish "process is up" from "process can actually serve
+// a solve".
+type warmup struct {
+	ready atomic.Bool
+}
+
+func (w *warmup) markReady() { w.ready.Store(true) }
+
+// handleHealthz always reports healthy once the process is serving at all:
+// it's a liveness check, not a readiness check, so it shouldn't fail just
+// because the dictionary is still loading.
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleReadyz reports whether the dictionary has finished loading and the
+// server can actually answer a solve request yet.
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if !s.warmup.ready.Load() {
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "warming up"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"status":     "ready",
+		"dictionary": s.dict.Len(),
+	})
+}
@@ -0,0 +1,185 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// Leaderboard stores result submissions from the sync client and answers
+// daily and streak leaderboard queries, backed by SQLite so results
+// survive a server restart.
+type Leaderboard struct {
+	db *sql.DB
+}
+
+// NewLeaderboard opens (and, if needed, creates) the leaderboard database
+// at path.
+func NewLeaderboard(path string) (*Leaderboard, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("server: open leaderboard database: %w", err)
+	}
+	const schema = `
+CREATE TABLE IF NOT EXISTS results (
+	game    TEXT NOT NULL,
+	player  TEXT NOT NULL,
+	day     TEXT NOT NULL,
+	words   INTEGER NOT NULL,
+	streak  INTEGER NOT NULL,
+	PRIMARY KEY (game, player, day)
+)`
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("server: create leaderboard schema: %w", err)
+	}
+	return &Leaderboard{db: db}, nil
+}
+
+// Close releases the underlying database handle.
+func (l *Leaderboard) Close() error {
+	return l.db.Close()
+}
+
+// Result is one player's submitted outcome for a single game on a single
+// day.
+type Result struct {
+	Game   string `json:"game"`
+	Player string `json:"player"`
+	Day    string `json:"day"`
+	Words  int    `json:"words"`
+	Streak int    `json:"streak"`
+}
+
+// Submit records (or overwrites) a player's result for the day, the way a
+// sync client re-submitting a corrected result would expect.
+func (l *Leaderboard) Submit(r Result) error {
+	_, err := l.db.Exec(
+		`INSERT OR REPLACE INTO results (game, player, day, words, streak) VALUES (?, ?, ?, ?, ?)`,
+		r.Game, r.Player, r.Day, r.Words, r.Streak,
+	)
+	if err != nil {
+		return fmt.Errorf("server: submit leaderboard result: %w", err)
+	}
+	return nil
+}
+
+// Daily returns every result for game on day, ordered by fewest words used
+// (best solve) first.
+func (l *Leaderboard) Daily(game, day string) ([]Result, error) {
+	rows, err := l.db.Query(
+		`SELECT game, player, day, words, streak FROM results WHERE game = ? AND day = ? ORDER BY words ASC`,
+		game, day,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("server: query daily leaderboard: %w", err)
+	}
+	defer rows.Close()
+	return scanResults(rows)
+}
+
+// Streaks returns every player's latest result for game, ordered by
+// longest streak first.
+func (l *Leaderboard) Streaks(game string) ([]Result, error) {
+	rows, err := l.db.Query(
+		`SELECT game, player, day, words, streak FROM results
+		 WHERE (player, day) IN (
+			SELECT player, MAX(day) FROM results WHERE game = ? GROUP BY player
+		 ) AND game = ?
+		 ORDER BY streak DESC`,
+		game, game,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("server: query streak leaderboard: %w", err)
+	}
+	defer rows.Close()
+	return scanResults(rows)
+}
+
+func scanResults(rows *sql.Rows) ([]Result, error) {
+	var results []Result
+	for rows.Next() {
+		var r Result
+		if err := rows.Scan(&r.Game, &r.Player, &r.Day, &r.Words, &r.Streak); err != nil {
+			return nil, fmt.Errorf("server: scan leaderboard row: %w", err)
+		}
+		results = append(results, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("server: read leaderboard rows: %w", err)
+	}
+	return results, nil
+}
+
+func (s *Server) handleLeaderboardSubmit(w http.ResponseWriter, r *http.Request) {
+	var result Result
+	if err := json.NewDecoder(r.Body).Decode(&result); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := s.leaderboard.Submit(result); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleLeaderboardDaily(w http.ResponseWriter, r *http.Request) {
+	game := r.PathValue("game")
+	day := r.URL.Query().Get("day")
+	if day == "" {
+		day = time.Now().UTC().Format("2006-01-02")
+	}
+	results, err := s.leaderboard.Daily(game, day)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+func (s *Server) handleLeaderboardStreaks(w http.ResponseWriter, r *http.Request) {
+	results, err := s.leaderboard.Streaks(r.PathValue("game"))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+var leaderboardPageTemplate = template.Must(template.New("leaderboard").Parse(`<!DOCTYPE html>
+<html>
+<head><title>{{.Game}} leaderboard</title></head>
+<body>
+<h1>{{.Game}} — {{.Day}}</h1>
+<table border="1">
+<tr><th>Player</th><th>Words</th><th>Streak</th></tr>
+{{range .Results}}<tr><td>{{.Player}}</td><td>{{.Words}}</td><td>{{.Streak}}</td></tr>
+{{end}}
+</table>
+</body>
+</html>
+`))
+
+func (s *Server) handleLeaderboardPage(w http.ResponseWriter, r *http.Request) {
+	game := r.PathValue("game")
+	day := r.URL.Query().Get("day")
+	if day == "" {
+		day = time.Now().UTC().Format("2006-01-02")
+	}
+	results, err := s.leaderboard.Daily(game, day)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = leaderboardPageTemplate.Execute(w, struct {
+		Game    string
+		Day     string
+		Results []Result
+	}{Game: game, Day: day, Results: results})
+}
@@ -0,0 +1,96 @@
+package server
+
+import "net/http"
+
+// openapiSpec is a hand-maintained OpenAPI 3.0 description of the daily
+// puzzle and single-puzzle solve routes; the batch solve and leaderboard
+// routes are not described in it. It's served as-is rather than generated
+// from route registrations.
+const openapiSpec = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "nyt-games Letter Boxed API",
+    "version": "1.0.0"
+  },
+  "paths": {
+    "/api/v1/puzzle/daily": {
+      "get": {
+        "summary": "Get today's generated puzzle",
+        "responses": {
+          "200": {
+            "description": "The daily puzzle",
+            "content": {
+              "application/json": {
+                "schema": { "$ref": "#/components/schemas/Puzzle" }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/puzzle/{sides}/solve": {
+      "get": {
+        "summary": "Solve a puzzle",
+        "parameters": [
+          {
+            "name": "sides",
+            "in": "path",
+            "required": true,
+            "schema": { "type": "string" },
+            "description": "Four comma-separated three-letter sides, e.g. abc,def,ghi,jkl"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Solutions for the puzzle",
+            "content": {
+              "application/json": {
+                "schema": { "$ref": "#/components/schemas/SolveResponse" }
+              }
+            }
+          },
+          "400": { "description": "Invalid sides" }
+        }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Puzzle": {
+        "type": "object",
+        "properties": {
+          "sides": {
+            "type": "array",
+            "items": { "type": "string" },
+            "minItems": 4,
+            "maxItems": 4
+          }
+        }
+      },
+      "SolveResponse": {
+        "type": "object",
+        "properties": {
+          "sides": {
+            "type": "array",
+            "items": { "type": "string" }
+          },
+          "solutions": {
+            "type": "array",
+            "items": {
+              "type": "array",
+              "items": { "type": "string" }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+// handleOpenAPI serves openapiSpec, which covers the daily puzzle and
+// single-puzzle solve routes under /api/v1.
+func (s *Server) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(openapiSpec))
+}
@@ -0,0 +1,193 @@
+// Package server exposes the Letter Boxed solver over HTTP.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aWarmWalrus/nyt-games/internal/letterboxed"
+)
+
+var (
+	errInvalidAPIKey = errors.New("server: missing or invalid API key")
+	errRateLimited   = errors.New("server: rate limit exceeded")
+)
+
+// Server holds the shared, warm dictionary used to answer every request.
+// dict and namedDicts are guarded by dictMu, since the admin API can
+// replace either one (see editDict) concurrently with requests that read
+// them — and with each other, since net/http serves each request on its
+// own goroutine.
+type Server struct {
+	dictMu      sync.RWMutex
+	dict        *letterboxed.Dictionary
+	namedDicts  map[string]*letterboxed.Dictionary
+	mux         *http.ServeMux
+	api         *http.ServeMux
+	warmup      warmup
+	leaderboard *Leaderboard
+	embargo     embargo
+	overrides   overrides
+	cache       *solveCache
+}
+
+// SetSolveCache enables an LRU cache of up to capacity solve responses,
+// keyed by dictionary and puzzle, so the common case of many requests for
+// the same daily puzzle is computed once. Cache hits and misses are
+// exposed as nytgames_solve_cache_{hits,misses}_total on /metrics.
+func (s *Server) SetSolveCache(capacity int) {
+	s.cache = newSolveCache(capacity)
+}
+
+// New builds a Server backed by dict and registers its routes. apiKeys is
+// the set of keys allowed to call the API; each key is limited to
+// requestsPerMinute requests per fixed one-minute window. The dictionary is already
+// loaded by the time New is called, so the server is marked ready
+// immediately; SetDictionary is provided for callers that load it
+// asynchronously instead.
+func New(dict *letterboxed.Dictionary, apiKeys map[string]bool, requestsPerMinute int) *Server {
+	s := &Server{dict: dict, mux: http.NewServeMux()}
+	s.routes(apiKeys, requestsPerMinute)
+	s.warmup.markReady()
+	return s
+}
+
+// SetDictionary swaps in a dictionary loaded after the server started
+// serving, and marks the server ready. Use this when the dictionary is
+// built asynchronously (e.g. a slow SQLite load) so /readyz reports
+// unready until it completes.
+func (s *Server) SetDictionary(dict *letterboxed.Dictionary) {
+	s.dictMu.Lock()
+	s.dict = dict
+	s.dictMu.Unlock()
+	s.warmup.markReady()
+}
+
+// SetNamedDictionary registers an additional warm dictionary under name,
+// selectable per request via the "dict" query parameter instead of always
+// answering from the default one. Registering the same name twice
+// replaces the earlier dictionary.
+func (s *Server) SetNamedDictionary(name string, dict *letterboxed.Dictionary) {
+	s.dictMu.Lock()
+	defer s.dictMu.Unlock()
+	if s.namedDicts == nil {
+		s.namedDicts = make(map[string]*letterboxed.Dictionary)
+	}
+	s.namedDicts[name] = dict
+}
+
+// dictFor resolves which dictionary a request should be answered from: the
+// one named by its "dict" query parameter, or the server's default if none
+// was given.
+func (s *Server) dictFor(r *http.Request) (*letterboxed.Dictionary, error) {
+	return s.namedOrDefault(r.URL.Query().Get("dict"))
+}
+
+// namedOrDefault resolves name to a registered named dictionary, or the
+// server's default dictionary if name is empty.
+func (s *Server) namedOrDefault(name string) (*letterboxed.Dictionary, error) {
+	s.dictMu.RLock()
+	defer s.dictMu.RUnlock()
+	if name == "" {
+		return s.dict, nil
+	}
+	d, ok := s.namedDicts[name]
+	if !ok {
+		return nil, fmt.Errorf("server: unknown dictionary %q", name)
+	}
+	return d, nil
+}
+
+// editDict applies edit to a freshly cloned copy of the named dictionary
+// (or the default one if name is empty) and swaps the clone into place.
+// Editing a private clone instead of the shared *Dictionary means a solve
+// already in flight against the old one keeps reading an object nothing
+// else ever mutates again, so it can't observe a trie half-way through an
+// insert or remove.
+func (s *Server) editDict(name string, edit func(*letterboxed.Dictionary)) error {
+	current, err := s.namedOrDefault(name)
+	if err != nil {
+		return err
+	}
+	updated := current.Clone()
+	edit(updated)
+
+	s.dictMu.Lock()
+	defer s.dictMu.Unlock()
+	if name == "" {
+		s.dict = updated
+	} else {
+		s.namedDicts[name] = updated
+	}
+	return nil
+}
+
+// SetLeaderboard attaches a Leaderboard, enabling the /api/v1/leaderboard/*
+// routes (behind the same API key and rate limit as the rest of the API)
+// and the HTML leaderboard page. Without one, those routes 404, so running
+// a leaderboard-backed server is opt-in rather than mandatory.
+func (s *Server) SetLeaderboard(lb *Leaderboard) {
+	s.leaderboard = lb
+	s.api.Handle("POST /api/v1/leaderboard/submit", withMetrics("leaderboard_submit", http.HandlerFunc(s.handleLeaderboardSubmit)))
+	s.api.Handle("GET /api/v1/leaderboard/{game}/daily", withMetrics("leaderboard_daily", http.HandlerFunc(s.handleLeaderboardDaily)))
+	s.api.Handle("GET /api/v1/leaderboard/{game}/streaks", withMetrics("leaderboard_streaks", http.HandlerFunc(s.handleLeaderboardStreaks)))
+	s.mux.Handle("GET /leaderboard/{game}", http.HandlerFunc(s.handleLeaderboardPage))
+}
+
+func (s *Server) routes(apiKeys map[string]bool, requestsPerMinute int) {
+	limiter := newRateLimiter(requestsPerMinute, time.Minute)
+
+	s.api = http.NewServeMux()
+	s.api.Handle("GET /api/v1/puzzle/daily", withMetrics("puzzle_daily", http.HandlerFunc(s.handleDailyPuzzle)))
+	s.api.Handle("GET /api/v1/puzzle/{sides}/solve", withMetrics("puzzle_solve", http.HandlerFunc(s.handleSolve)))
+	s.api.Handle("POST /api/v1/puzzle/solve/batch", withMetrics("puzzle_solve_batch", http.HandlerFunc(s.handleBatchSolve)))
+
+	s.mux.Handle("/api/", withAPIKeyAndRateLimit(apiKeys, limiter, s.api))
+	s.mux.Handle("/metrics", metricsHandler())
+	s.mux.Handle("/healthz", http.HandlerFunc(s.handleHealthz))
+	s.mux.Handle("/readyz", http.HandlerFunc(s.handleReadyz))
+	s.mux.Handle("/openapi.json", http.HandlerFunc(s.handleOpenAPI))
+	s.mux.Handle("/", http.HandlerFunc(s.handleIndex))
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+type puzzleResponse struct {
+	Sides [4]string `json:"sides"`
+}
+
+func (s *Server) handleDailyPuzzle(w http.ResponseWriter, r *http.Request) {
+	dict, err := s.dictFor(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	p, err := letterboxed.GenerateDaily(dict, time.Now())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, puzzleResponse{Sides: p.Sides})
+}
+
+type solveResponse struct {
+	Sides     [4]string  `json:"sides"`
+	Solutions [][]string `json:"solutions"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,17 @@
+package server
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+//go:embed assets/index.html
+var indexHTML []byte
+
+// handleIndex serves a minimal static web UI for trying the solver from a
+// browser. It calls the same /api/v1 routes as any other client, so it's
+// still subject to API key and rate limit checks.
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(indexHTML)
+}
@@ -0,0 +1,28 @@
+package spellingbee
+
+// AnswerDiff compares today's valid word list against yesterday's, for
+// players who want to see what's new without re-reading the whole list.
+type AnswerDiff struct {
+	New     []string
+	Carried []string
+}
+
+// DiffAnswers partitions today's words into ones that weren't valid
+// yesterday and ones that were valid both days (e.g. a word that happens
+// to be spelled using both days' letter sets).
+func DiffAnswers(today, yesterday []string) AnswerDiff {
+	wasValid := make(map[string]bool, len(yesterday))
+	for _, w := range yesterday {
+		wasValid[w] = true
+	}
+
+	var diff AnswerDiff
+	for _, w := range today {
+		if wasValid[w] {
+			diff.Carried = append(diff.Carried, w)
+		} else {
+			diff.New = append(diff.New, w)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,105 @@
+package spellingbee
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/plugin"
+)
+
+func init() {
+	plugin.Register(gamePlugin{})
+}
+
+// pangramSet is a Puzzle plus today's full answer list (used to find
+// pangrams and to diff against yesterday's), so the plugin adapter can
+// report progress and completion without needing a full dictionary
+// threaded through the generic Game interface.
+type pangramSet struct {
+	puzzle    Puzzle
+	today     []string
+	yesterday []string
+}
+
+// pangramsOf returns the pangrams among ps's today answer list.
+func (ps *pangramSet) pangramsOf() []string {
+	var pangrams []string
+	for _, w := range ps.today {
+		if ps.puzzle.IsPangram(w) {
+			pangrams = append(pangrams, w)
+		}
+	}
+	return pangrams
+}
+
+// gamePlugin adapts Spelling Bee to the plugin.Game interface.
+type gamePlugin struct{}
+
+// Name identifies this game for the --game=spellingbee CLI flag.
+func (gamePlugin) Name() string { return "spellingbee" }
+
+// NewPuzzle parses a spec of the form "<7 letters> <required letter>
+// <today's answers, comma-separated> [yesterday's answers,
+// comma-separated]", e.g. "praciet p practice,recap apt,rat". Guessing
+// the literal word "diff" reports how today's answers compare to
+// yesterday's, via DiffAnswers.
+func (gamePlugin) NewPuzzle(spec string) (any, error) {
+	fields := strings.Fields(spec)
+	if len(fields) < 3 || len(fields[0]) != 7 || len(fields[1]) != 1 {
+		return nil, fmt.Errorf("spellingbee: want \"<7 letters> <required letter> <today's answers> [yesterday's answers]\", got %q", spec)
+	}
+	var letters [7]byte
+	copy(letters[:], strings.ToLower(fields[0]))
+
+	ps := &pangramSet{
+		puzzle: NewPuzzle(letters, fields[1][0]),
+		today:  strings.Split(strings.ToLower(fields[2]), ","),
+	}
+	if len(fields) > 3 {
+		ps.yesterday = strings.Split(strings.ToLower(fields[3]), ",")
+	}
+	return ps, nil
+}
+
+// CheckGuess reports whether guess is playable on the puzzle and its
+// score, or, for the reserved guess "diff", compares today's answers
+// against yesterday's.
+func (gamePlugin) CheckGuess(puzzleAny any, guess string) (correct bool, message string) {
+	ps := puzzleAny.(*pangramSet)
+	if strings.EqualFold(guess, "diff") {
+		diff := DiffAnswers(ps.today, ps.yesterday)
+		return false, fmt.Sprintf("%d new since yesterday: %s; %d carried over: %s",
+			len(diff.New), strings.Join(diff.New, ", "), len(diff.Carried), strings.Join(diff.Carried, ", "))
+	}
+	if !ps.puzzle.IsValid(guess) {
+		return false, fmt.Sprintf("%q isn't playable: missing the required letter or using one not on the board", guess)
+	}
+	points := Score(guess)
+	if ps.puzzle.IsPangram(guess) {
+		points += 7
+		return true, fmt.Sprintf("pangram! %d points", points)
+	}
+	return true, fmt.Sprintf("%d points", points)
+}
+
+// Solved reports whether every pangram in today's answer list has been
+// guessed.
+func (gamePlugin) Solved(puzzleAny any, guesses []string) bool {
+	ps := puzzleAny.(*pangramSet)
+	pangrams := ps.pangramsOf()
+	if len(pangrams) == 0 {
+		return false
+	}
+	want := make(map[string]bool, len(pangrams))
+	for _, w := range pangrams {
+		want[w] = true
+	}
+	found := make(map[string]bool, len(want))
+	for _, g := range guesses {
+		g = strings.ToLower(strings.TrimSpace(g))
+		if want[g] {
+			found[g] = true
+		}
+	}
+	return len(found) == len(want)
+}
@@ -0,0 +1,90 @@
+// Package spellingbee implements scoring and pangram detection for the NYT
+// Spelling Bee puzzle: seven letters, one of them required, words of four
+// or more letters built only from those letters.
+package spellingbee
+
+import "strings"
+
+// Puzzle holds a Spelling Bee's seven letters, one of which is required to
+// appear in every valid word.
+type Puzzle struct {
+	Letters  [7]byte
+	Required byte
+}
+
+// NewPuzzle builds a Puzzle from its seven letters and the required one,
+// lower-casing them for consistent lookups.
+func NewPuzzle(letters [7]byte, required byte) Puzzle {
+	p := Puzzle{Required: required | ' '}
+	for i, l := range letters {
+		p.Letters[i] = l | ' '
+	}
+	return p
+}
+
+// mask returns a 26-bit set with one bit per distinct letter in s.
+func mask(s string) uint32 {
+	var m uint32
+	for i := 0; i < len(s); i++ {
+		m |= 1 << (s[i] - 'a')
+	}
+	return m
+}
+
+// letterMask is the set of letters allowed by p.
+func (p Puzzle) letterMask() uint32 {
+	var m uint32
+	for _, l := range p.Letters {
+		m |= 1 << (l - 'a')
+	}
+	return m
+}
+
+// IsValid reports whether word is playable on p: at least four letters,
+// built only from p's seven letters, and containing the required letter.
+func (p Puzzle) IsValid(word string) bool {
+	word = strings.ToLower(word)
+	if len(word) < 4 {
+		return false
+	}
+	if strings.IndexByte(word, p.Required) < 0 {
+		return false
+	}
+	allowed := p.letterMask()
+	return mask(word)&^allowed == 0
+}
+
+// IsPangram reports whether word uses all seven of p's letters at least
+// once.
+func (p Puzzle) IsPangram(word string) bool {
+	return mask(strings.ToLower(word))&p.letterMask() == p.letterMask()
+}
+
+// Score returns a word's base point value: 1 point for a four-letter word,
+// one point per letter for longer words. It doesn't include the 7-point
+// pangram bonus, which callers such as MaxScore add on top.
+func Score(word string) int {
+	points := 1
+	if len(word) > 4 {
+		points = len(word)
+	}
+	return points
+}
+
+// MaxScore computes the total score and the pangrams found across every
+// valid word in words, the way the NYT "Genius"/"Queen Bee" thresholds are
+// derived from the full solution set.
+func MaxScore(p Puzzle, words []string) (total int, pangrams []string) {
+	for _, word := range words {
+		if !p.IsValid(word) {
+			continue
+		}
+		points := Score(word)
+		if p.IsPangram(word) {
+			points += 7
+			pangrams = append(pangrams, word)
+		}
+		total += points
+	}
+	return total, pangrams
+}
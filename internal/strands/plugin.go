@@ -0,0 +1,83 @@
+package strands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/plugin"
+)
+
+func init() {
+	plugin.Register(gamePlugin{})
+}
+
+// board is a Grid plus the spangram and theme words it's known to have, so
+// the plugin adapter can report progress and completion.
+type board struct {
+	grid     Grid
+	spangram string
+	themes   map[string]bool
+}
+
+// gamePlugin adapts Strands to the plugin.Game interface.
+type gamePlugin struct{}
+
+// Name identifies this game for the --game=strands CLI flag.
+func (gamePlugin) Name() string { return "strands" }
+
+// NewPuzzle parses a spec of the form "<rows>;<spangram>;<theme words,
+// comma-separated>", where rows is itself comma-separated, e.g.
+// "abcd,efgh,ijkl,mnop;bfjn;cgko".
+func (gamePlugin) NewPuzzle(spec string) (any, error) {
+	parts := strings.Split(spec, ";")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("strands: want \"<rows>;<spangram>;<theme words>\", got %q", spec)
+	}
+	rows := strings.Split(parts[0], ",")
+	b := &board{
+		grid:     NewGrid(rows),
+		spangram: strings.ToLower(strings.TrimSpace(parts[1])),
+		themes:   make(map[string]bool),
+	}
+	for _, w := range strings.Split(parts[2], ",") {
+		b.themes[strings.ToLower(strings.TrimSpace(w))] = true
+	}
+	return b, nil
+}
+
+// CheckGuess reports whether guess can be traced in the grid and whether
+// it's the spangram or a theme word.
+func (gamePlugin) CheckGuess(puzzleAny any, guess string) (correct bool, message string) {
+	b := puzzleAny.(*board)
+	guess = strings.ToLower(guess)
+	if len(b.grid.Find(guess)) == 0 {
+		return false, fmt.Sprintf("%q can't be traced on this grid", guess)
+	}
+	switch {
+	case guess == b.spangram:
+		return true, "spangram!"
+	case b.themes[guess]:
+		return true, "theme word"
+	default:
+		return false, "found on the grid, but not one of today's words"
+	}
+}
+
+// Solved reports whether the spangram and every theme word have been
+// guessed.
+func (gamePlugin) Solved(puzzleAny any, guesses []string) bool {
+	b := puzzleAny.(*board)
+	found := make(map[string]bool, len(b.themes)+1)
+	for _, g := range guesses {
+		found[strings.ToLower(strings.TrimSpace(g))] = true
+	}
+	if !found[b.spangram] {
+		return false
+	}
+	for w := range b.themes {
+		if !found[w] {
+			return false
+		}
+	}
+	return true
+}
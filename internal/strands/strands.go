@@ -0,0 +1,108 @@
+// Package strands implements word search over the NYT Strands grid: words
+// are found by connecting adjacent letters (including diagonals), each
+// cell used at most once per word. One theme word, the spangram, touches
+// two opposite sides of the grid.
+package strands
+
+// Grid is a rectangular letter grid searched for theme words.
+type Grid struct {
+	Rows   int
+	Cols   int
+	Letter [][]byte
+}
+
+// NewGrid builds a Grid from rows of equal-length letter strings.
+func NewGrid(rows []string) Grid {
+	g := Grid{Rows: len(rows)}
+	if len(rows) > 0 {
+		g.Cols = len(rows[0])
+	}
+	g.Letter = make([][]byte, g.Rows)
+	for i, row := range rows {
+		g.Letter[i] = []byte(row)
+	}
+	return g
+}
+
+type pos struct{ r, c int }
+
+var neighborOffsets = [8][2]int{
+	{-1, -1}, {-1, 0}, {-1, 1},
+	{0, -1}, {0, 1},
+	{1, -1}, {1, 0}, {1, 1},
+}
+
+// Find returns every path of grid cells that spells word by stepping
+// between 8-directionally adjacent cells without reusing a cell, as a
+// sequence of (row, col) positions.
+func (g Grid) Find(word string) [][]pos {
+	if word == "" {
+		return nil
+	}
+	var results [][]pos
+	var visited = make([][]bool, g.Rows)
+	for i := range visited {
+		visited[i] = make([]bool, g.Cols)
+	}
+
+	var walk func(r, c, depth int, path []pos)
+	walk = func(r, c, depth int, path []pos) {
+		path = append(path, pos{r, c})
+		if depth == len(word)-1 {
+			found := make([]pos, len(path))
+			copy(found, path)
+			results = append(results, found)
+			return
+		}
+		visited[r][c] = true
+		for _, off := range neighborOffsets {
+			nr, nc := r+off[0], c+off[1]
+			if nr < 0 || nr >= g.Rows || nc < 0 || nc >= g.Cols || visited[nr][nc] {
+				continue
+			}
+			if g.Letter[nr][nc] != word[depth+1] {
+				continue
+			}
+			walk(nr, nc, depth+1, path)
+		}
+		visited[r][c] = false
+	}
+
+	for r := 0; r < g.Rows; r++ {
+		for c := 0; c < g.Cols; c++ {
+			if g.Letter[r][c] == word[0] {
+				walk(r, c, 0, nil)
+			}
+		}
+	}
+	return results
+}
+
+// touchesOppositeSides reports whether path has at least one cell in each
+// of two opposite edges of the grid (both the top and bottom rows, or both
+// the left and right columns), the shape every spangram must have.
+func (g Grid) touchesOppositeSides(path []pos) bool {
+	var top, bottom, left, right bool
+	for _, p := range path {
+		top = top || p.r == 0
+		bottom = bottom || p.r == g.Rows-1
+		left = left || p.c == 0
+		right = right || p.c == g.Cols-1
+	}
+	return (top && bottom) || (left && right)
+}
+
+// FindSpangram searches candidates (typically every dictionary word that
+// fits the grid's letters) for the first one whose path touches two
+// opposite sides of the grid, prioritizing the spangram over theme words
+// since it anchors the rest of the puzzle.
+func (g Grid) FindSpangram(candidates []string) (word string, path []pos, ok bool) {
+	for _, word := range candidates {
+		for _, path := range g.Find(word) {
+			if g.touchesOppositeSides(path) {
+				return word, path, true
+			}
+		}
+	}
+	return "", nil, false
+}
@@ -0,0 +1,151 @@
+package sudoku
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/plugin"
+)
+
+func init() {
+	plugin.Register(gamePlugin{})
+}
+
+// session is a Sudoku in progress: the player's current board, the
+// original givens (which can't be overwritten), the solution Solve found
+// up front (which guesses are checked against), and any Killer/thermo
+// constraints the puzzle also has to satisfy.
+type session struct {
+	given    Board
+	board    Board
+	solution Board
+	variant  VariantBoard
+}
+
+// gamePlugin adapts Sudoku to the plugin.Game interface.
+type gamePlugin struct{}
+
+// Name identifies this game for the --game=sudoku CLI flag.
+func (gamePlugin) Name() string { return "sudoku" }
+
+// NewPuzzle parses an 81-character board spec, read row by row, with '0'
+// or '.' for a blank cell, e.g. "53..7...." (81 characters total),
+// optionally followed by "|"-separated Killer cage and thermometer
+// constraints: "cage:r,c;r,c;...=sum" or "thermo:r,c;r,c;...", e.g.
+// "53..7....|cage:0,0;0,1=10|thermo:1,0;1,1;1,2". The puzzle must have a
+// standard solution, which is solved for up front via Solve and is also
+// checked against any variant constraints.
+func (gamePlugin) NewPuzzle(spec string) (any, error) {
+	segments := strings.Split(strings.TrimSpace(spec), "|")
+	boardSpec := segments[0]
+	if len(boardSpec) != 81 {
+		return nil, fmt.Errorf("sudoku: want an 81-character board, got %d characters", len(boardSpec))
+	}
+	var given Board
+	for i, ch := range []byte(boardSpec) {
+		r, c := i/9, i%9
+		switch {
+		case ch == '0' || ch == '.':
+			given[r][c] = 0
+		case ch >= '1' && ch <= '9':
+			given[r][c] = int(ch - '0')
+		default:
+			return nil, fmt.Errorf("sudoku: invalid character %q at position %d", ch, i)
+		}
+	}
+
+	var variant VariantBoard
+	for _, seg := range segments[1:] {
+		kind, body, ok := strings.Cut(seg, ":")
+		if !ok {
+			return nil, fmt.Errorf("sudoku: constraint %q missing a \"kind:cells\" colon", seg)
+		}
+		switch kind {
+		case "cage":
+			cellsPart, sumPart, ok := strings.Cut(body, "=")
+			if !ok {
+				return nil, fmt.Errorf("sudoku: cage %q missing \"=<sum>\"", seg)
+			}
+			cells, err := parseCells(cellsPart)
+			if err != nil {
+				return nil, fmt.Errorf("sudoku: cage %q: %w", seg, err)
+			}
+			sum, err := strconv.Atoi(sumPart)
+			if err != nil {
+				return nil, fmt.Errorf("sudoku: cage %q: bad sum: %w", seg, err)
+			}
+			variant.Cages = append(variant.Cages, Cage{Cells: cells, Sum: sum})
+		case "thermo":
+			cells, err := parseCells(body)
+			if err != nil {
+				return nil, fmt.Errorf("sudoku: thermo %q: %w", seg, err)
+			}
+			variant.Thermos = append(variant.Thermos, Thermo{Cells: cells})
+		default:
+			return nil, fmt.Errorf("sudoku: unknown constraint kind %q", kind)
+		}
+	}
+
+	solution, ok := Solve(given)
+	if !ok {
+		return nil, fmt.Errorf("sudoku: board has no solution")
+	}
+	if !variant.Valid(solution) {
+		return nil, fmt.Errorf("sudoku: solution doesn't satisfy the given variant constraints")
+	}
+	return &session{given: given, board: given, solution: solution, variant: variant}, nil
+}
+
+// parseCells parses a ";"-separated list of "row,col" pairs.
+func parseCells(s string) ([][2]int, error) {
+	var cells [][2]int
+	for _, pair := range strings.Split(s, ";") {
+		rStr, cStr, ok := strings.Cut(pair, ",")
+		if !ok {
+			return nil, fmt.Errorf("cell %q wants \"row,col\"", pair)
+		}
+		r, err := strconv.Atoi(rStr)
+		if err != nil {
+			return nil, fmt.Errorf("cell %q: bad row: %w", pair, err)
+		}
+		c, err := strconv.Atoi(cStr)
+		if err != nil {
+			return nil, fmt.Errorf("cell %q: bad col: %w", pair, err)
+		}
+		cells = append(cells, [2]int{r, c})
+	}
+	return cells, nil
+}
+
+// CheckGuess fills in a cell from a guess of the form "<row> <col> <digit>"
+// (0-indexed row/col, 1-9 digit), checked against the precomputed
+// solution.
+func (gamePlugin) CheckGuess(puzzleAny any, guess string) (correct bool, message string) {
+	s := puzzleAny.(*session)
+	fields := strings.Fields(guess)
+	if len(fields) != 3 {
+		return false, "guesses must be \"<row> <col> <digit>\", e.g. \"0 2 5\""
+	}
+	r, err1 := strconv.Atoi(fields[0])
+	c, err2 := strconv.Atoi(fields[1])
+	d, err3 := strconv.Atoi(fields[2])
+	if err1 != nil || err2 != nil || err3 != nil || r < 0 || r > 8 || c < 0 || c > 8 || d < 1 || d > 9 {
+		return false, "row/col must be 0-8 and digit must be 1-9"
+	}
+	if s.given[r][c] != 0 {
+		return false, fmt.Sprintf("(%d,%d) is a given clue, can't be changed", r, c)
+	}
+	if d != s.solution[r][c] {
+		return false, fmt.Sprintf("(%d,%d): not it", r, c)
+	}
+	s.board[r][c] = d
+	return true, fmt.Sprintf("(%d,%d): correct", r, c)
+}
+
+// Solved reports whether every cell matches the solution and, if the
+// puzzle has Killer cages or thermometers, that they're still satisfied.
+func (gamePlugin) Solved(puzzleAny any, guesses []string) bool {
+	s := puzzleAny.(*session)
+	return s.board == s.solution && s.variant.Valid(s.board)
+}
@@ -0,0 +1,141 @@
+// Package sudoku implements a standard 9x9 Sudoku board, solving via the
+// exactcover package, and generation with a difficulty target based on how
+// many cells are given.
+package sudoku
+
+import "github.com/aWarmWalrus/nyt-games/internal/exactcover"
+
+// Board is a 9x9 Sudoku grid; 0 marks an empty cell.
+type Board [9][9]int
+
+// numItems is the exact-cover universe size: 4 constraint families (cell
+// filled, row has digit, column has digit, box has digit), each with
+// 9*9 = 81 possibilities.
+const numItems = 4 * 9 * 9
+
+// optionItems returns the four constraint indices satisfied by placing
+// digit (1-9) at (r, c).
+func optionItems(r, c, digit int) [4]int {
+	box := (r/3)*3 + c/3
+	d := digit - 1
+	return [4]int{
+		r*9 + c, // cell filled
+		81 + r*9 + d,
+		162 + c*9 + d,
+		243 + box*9 + d,
+	}
+}
+
+// Solve returns a completed Board consistent with the given clues, or ok
+// == false if none exists.
+func Solve(given Board) (Board, bool) {
+	var options []exactcover.Option
+	for r := 0; r < 9; r++ {
+		for c := 0; c < 9; c++ {
+			digits := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+			if given[r][c] != 0 {
+				digits = []int{given[r][c]}
+			}
+			for _, d := range digits {
+				items := optionItems(r, c, d)
+				options = append(options, exactcover.Option{
+					ID:    r*9*9 + c*9 + (d - 1),
+					Items: items[:],
+				})
+			}
+		}
+	}
+
+	chosen := exactcover.Solve(numItems, options)
+	if chosen == nil {
+		return Board{}, false
+	}
+	var board Board
+	for _, id := range chosen {
+		r, c, d := id/81, (id/9)%9, id%9+1
+		board[r][c] = d
+	}
+	return board, true
+}
+
+// Difficulty buckets a generated puzzle by how many cells are given:
+// more givens make for an easier puzzle.
+type Difficulty int
+
+const (
+	Easy Difficulty = iota
+	Medium
+	Hard
+)
+
+// givensFor maps a difficulty to the number of clues left on the board.
+func givensFor(d Difficulty) int {
+	switch d {
+	case Easy:
+		return 40
+	case Medium:
+		return 32
+	default:
+		return 26
+	}
+}
+
+// Generate builds a puzzle at the requested difficulty from solved, a
+// complete solution supplied by the caller, by removing cells down to
+// the target given-count one at a time, backing off a removal whenever it
+// would leave the puzzle with more than one solution.
+func Generate(solved Board, difficulty Difficulty, rng func(n int) int) Board {
+	var cells [81]int
+	for i := range cells {
+		cells[i] = i
+	}
+	for i := len(cells) - 1; i > 0; i-- {
+		j := rng(i + 1)
+		cells[i], cells[j] = cells[j], cells[i]
+	}
+
+	puzzle := solved
+	toRemove := 81 - givensFor(difficulty)
+	removed := 0
+	for i := 0; i < len(cells) && removed < toRemove; i++ {
+		r, c := cells[i]/9, cells[i]%9
+		given := puzzle[r][c]
+		puzzle[r][c] = 0
+		if hasUniqueSolution(puzzle) {
+			removed++
+		} else {
+			puzzle[r][c] = given
+		}
+	}
+	return puzzle
+}
+
+// hasUniqueSolution reports whether puzzle has exactly one completion: it
+// solves puzzle once via exactcover, then checks every other digit that
+// could legally go in each still-empty cell, confirming none of them also
+// leads to a solution. A puzzle with more than one solution has at least
+// one cell where this swap succeeds.
+func hasUniqueSolution(puzzle Board) bool {
+	solution, ok := Solve(puzzle)
+	if !ok {
+		return false
+	}
+	for r := 0; r < 9; r++ {
+		for c := 0; c < 9; c++ {
+			if puzzle[r][c] != 0 {
+				continue
+			}
+			for d := 1; d <= 9; d++ {
+				if d == solution[r][c] {
+					continue
+				}
+				alt := puzzle
+				alt[r][c] = d
+				if _, ok := Solve(alt); ok {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package sudoku
+
+// Cage is a Killer Sudoku cage: a set of cells whose digits must sum to
+// Sum and (per standard Killer rules) never repeat a digit within the
+// cage.
+type Cage struct {
+	Cells [][2]int
+	Sum   int
+}
+
+// Thermo is a thermometer constraint: digits along Cells must strictly
+// increase starting from the bulb (Cells[0]).
+type Thermo struct {
+	Cells [][2]int
+}
+
+// VariantBoard extends the standard Sudoku rules with optional Killer
+// cages and thermometers, checked in addition to (not instead of) the
+// normal row/column/box constraints.
+type VariantBoard struct {
+	Cages   []Cage
+	Thermos []Thermo
+}
+
+// ValidCages reports whether every cage in v sums correctly and has no
+// repeated digit, given a completed board.
+func (v VariantBoard) ValidCages(board Board) bool {
+	for _, cage := range v.Cages {
+		sum := 0
+		seen := make(map[int]bool, len(cage.Cells))
+		for _, rc := range cage.Cells {
+			d := board[rc[0]][rc[1]]
+			if d == 0 || seen[d] {
+				return false
+			}
+			seen[d] = true
+			sum += d
+		}
+		if sum != cage.Sum {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidThermos reports whether every thermometer in v has strictly
+// increasing digits from bulb to tip, given a completed board.
+func (v VariantBoard) ValidThermos(board Board) bool {
+	for _, thermo := range v.Thermos {
+		for i := 1; i < len(thermo.Cells); i++ {
+			prev := thermo.Cells[i-1]
+			cur := thermo.Cells[i]
+			if board[cur[0]][cur[1]] <= board[prev[0]][prev[1]] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Valid reports whether board satisfies both the cage and thermometer
+// constraints in v. Standard row/column/box/givens validity is the
+// responsibility of Solve, which this doesn't duplicate.
+func (v VariantBoard) Valid(board Board) bool {
+	return v.ValidCages(board) && v.ValidThermos(board)
+}
@@ -0,0 +1,55 @@
+package wordle
+
+// AdversarialFeedback picks the feedback pattern for guess that's
+// consistent with the largest number of remaining candidates, the way
+// Absurdle plays: rather than committing to a fixed answer up front, it
+// keeps every answer alive that it possibly can, for as long as it can.
+//
+// It returns the chosen marks and the candidates still consistent with
+// them.
+func AdversarialFeedback(candidates []string, guess string) ([5]Mark, []string) {
+	buckets := make(map[[5]Mark][]string)
+	for _, answer := range candidates {
+		marks := Feedback(guess, answer)
+		buckets[marks] = append(buckets[marks], answer)
+	}
+
+	var best [5]Mark
+	var bestBucket []string
+	for marks, bucket := range buckets {
+		if len(bucket) > len(bestBucket) || (len(bucket) == len(bestBucket) && marksLess(marks, best)) {
+			best = marks
+			bestBucket = bucket
+		}
+	}
+	return best, bestBucket
+}
+
+// marksLess gives bucket ties a deterministic tie-break, since map
+// iteration order isn't stable: all-gray (the numerically smallest Mark
+// array) is preferred, matching Absurdle's tendency to stall the player
+// with unhelpful feedback whenever it can.
+func marksLess(a, b [5]Mark) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
+
+// PlayAbsurdle narrows candidates one guess at a time using
+// AdversarialFeedback, returning the feedback shown for each guess in
+// sequence. It stops early if only one candidate remains.
+func PlayAbsurdle(candidates []string, guesses []string) [][5]Mark {
+	var history [][5]Mark
+	for _, guess := range guesses {
+		if len(candidates) <= 1 {
+			break
+		}
+		marks, remaining := AdversarialFeedback(candidates, guess)
+		history = append(history, marks)
+		candidates = remaining
+	}
+	return history
+}
@@ -0,0 +1,89 @@
+package wordle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/plugin"
+)
+
+func init() {
+	plugin.Register(gamePlugin{})
+}
+
+// session is a Wordle answer plus the candidate pool still consistent with
+// feedback shown so far, so the plugin adapter can report narrowing
+// probabilities after every guess.
+type session struct {
+	answer     string
+	candidates []string
+}
+
+// gamePlugin adapts Wordle to the plugin.Game interface.
+type gamePlugin struct{}
+
+// Name identifies this game for the --game=wordle CLI flag.
+func (gamePlugin) Name() string { return "wordle" }
+
+// NewPuzzle parses a spec of the form "<5-letter answer>
+// <comma-separated candidate pool>", e.g. "crane crane,slate,crate,grade".
+func (gamePlugin) NewPuzzle(spec string) (any, error) {
+	fields := strings.Fields(spec)
+	if len(fields) == 0 || len(fields[0]) != 5 {
+		return nil, fmt.Errorf("wordle: want \"<5-letter answer> [candidate pool]\", got %q", spec)
+	}
+	s := &session{answer: strings.ToLower(fields[0])}
+	if len(fields) > 1 {
+		s.candidates = strings.Split(strings.ToLower(fields[1]), ",")
+	}
+	return s, nil
+}
+
+// markSymbol renders a Mark the way a terminal can show it without color.
+func markSymbol(m Mark) byte {
+	switch m {
+	case Green:
+		return 'G'
+	case Yellow:
+		return 'Y'
+	default:
+		return '_'
+	}
+}
+
+// CheckGuess scores guess against the answer and, if a candidate pool was
+// given, reports how many candidates the feedback leaves standing.
+func (gamePlugin) CheckGuess(puzzleAny any, guess string) (correct bool, message string) {
+	s := puzzleAny.(*session)
+	guess = strings.ToLower(guess)
+	if len(guess) != 5 {
+		return false, "guesses must be five letters"
+	}
+
+	marks := Feedback(guess, s.answer)
+	symbols := make([]byte, 5)
+	for i, m := range marks {
+		symbols[i] = markSymbol(m)
+	}
+
+	if s.candidates != nil {
+		probs := CandidateProbabilities(s.candidates, guess, marks)
+		s.candidates = make([]string, 0, len(probs))
+		for c := range probs {
+			s.candidates = append(s.candidates, c)
+		}
+		return guess == s.answer, fmt.Sprintf("%s (%d candidates remain)", symbols, len(s.candidates))
+	}
+	return guess == s.answer, string(symbols)
+}
+
+// Solved reports whether the answer has been guessed.
+func (gamePlugin) Solved(puzzleAny any, guesses []string) bool {
+	s := puzzleAny.(*session)
+	for _, g := range guesses {
+		if strings.ToLower(strings.TrimSpace(g)) == s.answer {
+			return true
+		}
+	}
+	return false
+}
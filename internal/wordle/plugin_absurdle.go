@@ -0,0 +1,73 @@
+package wordle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aWarmWalrus/nyt-games/internal/plugin"
+)
+
+func init() {
+	plugin.Register(absurdlePlugin{})
+}
+
+// absurdleSession is the shrinking candidate pool an Absurdle game
+// narrows with each guess, via AdversarialFeedback instead of scoring
+// against a fixed secret answer.
+type absurdleSession struct {
+	candidates []string
+}
+
+// absurdlePlugin adapts Absurdle to the plugin.Game interface.
+type absurdlePlugin struct{}
+
+// Name identifies this game for the --game=wordle-absurdle CLI flag.
+func (absurdlePlugin) Name() string { return "wordle-absurdle" }
+
+// NewPuzzle parses a spec of comma-separated five-letter candidate words,
+// e.g. "crane,slate,crate,grade".
+func (absurdlePlugin) NewPuzzle(spec string) (any, error) {
+	var candidates []string
+	for _, w := range strings.Split(spec, ",") {
+		w = strings.ToLower(strings.TrimSpace(w))
+		if len(w) != 5 {
+			return nil, fmt.Errorf("wordle-absurdle: candidate %q isn't five letters", w)
+		}
+		candidates = append(candidates, w)
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("wordle-absurdle: want a comma-separated candidate pool, got %q", spec)
+	}
+	return &absurdleSession{candidates: candidates}, nil
+}
+
+// CheckGuess picks the feedback pattern that keeps the most candidates
+// alive and narrows the pool to them, the way Absurdle stalls the player
+// as long as it can.
+func (absurdlePlugin) CheckGuess(puzzleAny any, guess string) (correct bool, message string) {
+	s := puzzleAny.(*absurdleSession)
+	guess = strings.ToLower(guess)
+	if len(guess) != 5 {
+		return false, "guesses must be five letters"
+	}
+
+	marks, remaining := AdversarialFeedback(s.candidates, guess)
+	s.candidates = remaining
+	symbols := make([]byte, 5)
+	for i, m := range marks {
+		symbols[i] = markSymbol(m)
+	}
+
+	won := len(s.candidates) == 1 && s.candidates[0] == guess
+	return won, fmt.Sprintf("%s (%d candidates remain)", symbols, len(s.candidates))
+}
+
+// Solved reports whether the pool has been narrowed down to the single
+// word the player just guessed.
+func (absurdlePlugin) Solved(puzzleAny any, guesses []string) bool {
+	s := puzzleAny.(*absurdleSession)
+	if len(s.candidates) != 1 || len(guesses) == 0 {
+		return false
+	}
+	return strings.ToLower(strings.TrimSpace(guesses[len(guesses)-1])) == s.candidates[0]
+}
@@ -0,0 +1,70 @@
+// Package wordle implements guess feedback and candidate narrowing for the
+// NYT Wordle puzzle: five-letter words, guessed with green/yellow/gray
+// feedback per letter.
+package wordle
+
+// Mark is the feedback color for one letter of a guess.
+type Mark int
+
+const (
+	Gray Mark = iota
+	Yellow
+	Green
+)
+
+// Feedback scores guess against answer the way Wordle does: a letter is
+// Green if it's in the right position, Yellow if it appears elsewhere in
+// answer (accounting for letters already claimed by a Green or an earlier
+// Yellow), and Gray otherwise.
+func Feedback(guess, answer string) [5]Mark {
+	var marks [5]Mark
+	var used [5]bool
+
+	for i := 0; i < 5; i++ {
+		if guess[i] == answer[i] {
+			marks[i] = Green
+			used[i] = true
+		}
+	}
+	for i := 0; i < 5; i++ {
+		if marks[i] == Green {
+			continue
+		}
+		for j := 0; j < 5; j++ {
+			if !used[j] && answer[j] == guess[i] {
+				marks[i] = Yellow
+				used[j] = true
+				break
+			}
+		}
+	}
+	return marks
+}
+
+// Matches reports whether candidate is consistent with having produced
+// marks when guessed against the true answer: i.e. whether candidate could
+// still be the answer given what guess revealed.
+func Matches(candidate, guess string, marks [5]Mark) bool {
+	return Feedback(guess, candidate) == marks
+}
+
+// CandidateProbabilities narrows candidates to the ones consistent with
+// guess producing marks, and reports each survivor's probability assuming
+// a uniform prior over the remaining candidates.
+func CandidateProbabilities(candidates []string, guess string, marks [5]Mark) map[string]float64 {
+	var survivors []string
+	for _, c := range candidates {
+		if Matches(c, guess, marks) {
+			survivors = append(survivors, c)
+		}
+	}
+	if len(survivors) == 0 {
+		return nil
+	}
+	probs := make(map[string]float64, len(survivors))
+	p := 1.0 / float64(len(survivors))
+	for _, c := range survivors {
+		probs[c] = p
+	}
+	return probs
+}